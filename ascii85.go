@@ -0,0 +1,141 @@
+package main
+
+import (
+	"encoding/binary"
+	"errors"
+	"strings"
+)
+
+type (
+	ascii85 string
+	z85     string
+)
+
+const z85Alphabet = "0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ.-:+=^!/*?&<>()[]{}@%$#"
+
+var (
+	ErrInvalidA85Len  = errors.New("fromAscii85String: invalid input length")
+	ErrInvalidA85Char = errors.New("fromAscii85String: invalid input char")
+	ErrInvalidZ85Len  = errors.New("fromZ85String: invalid input length")
+	ErrInvalidZ85Char = errors.New("fromZ85String: invalid input char")
+)
+
+// Writes the five base 85 digits of x to dst using alphabet.
+func encodeBase85Group(dst []byte, x uint32, alphabet func(uint32) byte) {
+	for i := 4; i >= 0; i-- {
+		dst[i] = alphabet(x % 85)
+		x /= 85
+	}
+}
+
+// Ascii85 as used by btoa and PostScript: digits are '!' to 'u', an all-zero
+// group is written as 'z' and a final group of n bytes as n+1 digits. The
+// output has no "<~" "~>" delimiters.
+func toAscii85String(bytes []byte) ascii85 {
+	buf := make([]byte, 0, (len(bytes)+3)/4*5)
+	var group [5]byte
+	digit := func(x uint32) byte { return byte('!' + x) }
+	for i := 0; i < len(bytes); i += 4 {
+		var word [4]byte
+		n := copy(word[:], bytes[i:])
+		x := binary.BigEndian.Uint32(word[:])
+		if x == 0 && n == 4 {
+			buf = append(buf, 'z')
+			continue
+		}
+		encodeBase85Group(group[:], x, digit)
+		buf = append(buf, group[:n+1]...)
+	}
+	return ascii85(buf)
+}
+
+// fromAscii85String accepts input with or without "<~" "~>" delimiters and
+// ignores whitespace.
+func fromAscii85String(s ascii85) ([]byte, error) {
+	start, end := 0, len(s)
+	if strings.HasPrefix(string(s), "<~") {
+		start += 2
+	}
+	if strings.HasSuffix(string(s[start:]), "~>") {
+		end -= 2
+	}
+	buf := make([]byte, 0, (end-start)/5*4+4)
+	var x uint64
+	n := 0     // Digits in the current group
+	first := 0 // Offset of the first digit of the current group
+	for i := start; i < end; i++ {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f' || c == '\v':
+			continue
+		case c == 'z' && n == 0:
+			buf = append(buf, 0, 0, 0, 0)
+			continue
+		case c < '!' || c > 'u':
+			return nil, charError("ascii85", ErrInvalidA85Char, string(s), i)
+		}
+		if n == 0 {
+			first = i
+		}
+		x = x*85 + uint64(c-'!')
+		n++
+		if n == 5 {
+			if x > 1<<32-1 {
+				return nil, charError("ascii85", ErrInvalidA85Char, string(s), first)
+			}
+			buf = append(buf, byte(x>>24), byte(x>>16), byte(x>>8), byte(x))
+			x, n = 0, 0
+		}
+	}
+	switch n {
+	case 0:
+		return buf, nil
+	case 1:
+		return nil, lenError("ascii85", ErrInvalidA85Len, string(s))
+	}
+	// Pad the final group with the highest digit and keep n-1 bytes.
+	for i := n; i < 5; i++ {
+		x = x*85 + 84
+	}
+	if x > 1<<32-1 {
+		return nil, charError("ascii85", ErrInvalidA85Char, string(s), first)
+	}
+	word := [4]byte{byte(x >> 24), byte(x >> 16), byte(x >> 8), byte(x)}
+	return append(buf, word[:n-1]...), nil
+}
+
+// Z85 as specified by ZeroMQ RFC 32, where the input length must be a multiple
+// of four.
+func toZ85String(bytes []byte) (z85, error) {
+	if len(bytes)%4 != 0 {
+		return "", ErrInvalidZ85Len
+	}
+	buf := make([]byte, len(bytes)/4*5)
+	digit := func(x uint32) byte { return z85Alphabet[x] }
+	for i := 0; i < len(bytes); i += 4 {
+		encodeBase85Group(buf[i/4*5:], binary.BigEndian.Uint32(bytes[i:]), digit)
+	}
+	return z85(buf), nil
+}
+
+func fromZ85String(s z85) ([]byte, error) {
+	if len(s)%5 != 0 {
+		return nil, lenError("z85", ErrInvalidZ85Len, string(s))
+	}
+	buf := make([]byte, len(s)/5*4)
+	for i := 0; i < len(s); i += 5 {
+		var x uint64
+		for j := i; j < i+5; j++ {
+			d := strings.IndexByte(z85Alphabet, s[j])
+			if d == -1 {
+				return nil, charError("z85", ErrInvalidZ85Char, string(s), j)
+			}
+			x = x*85 + uint64(d)
+		}
+		if x > 1<<32-1 {
+			return nil, charError("z85", ErrInvalidZ85Char, string(s), i)
+		}
+		binary.BigEndian.PutUint32(buf[i/5*4:], uint32(x))
+	}
+	return buf, nil
+}
@@ -0,0 +1,75 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestAscii85(t *testing.T) {
+	tests := []struct {
+		input string
+		want  ascii85
+	}{
+		{"", ""},
+		{"Man is distinguished", "9jqo^BlbD-BleB1DJ+*+F(f,q"},
+		{"Man", "9jqo"},
+		{"\x00\x00\x00\x00\x00", "z!!"},
+	}
+	for _, test := range tests {
+		if got := toAscii85String([]byte(test.input)); got != test.want {
+			t.Errorf("toAscii85String(%q) = '%v', want '%v'", test.input, got, test.want)
+		}
+	}
+
+	got, err := fromAscii85String("<~9jqo^BlbD-\nBleB1DJ+*+F(f,q~>")
+	if err != nil || string(got) != "Man is distinguished" {
+		t.Errorf("fromAscii85String = '%s', '%v'", got, err)
+	}
+	for _, input := range []ascii85{"9jqo^B", "9jqoz"} {
+		if _, err := fromAscii85String(input); err == nil {
+			t.Errorf("fromAscii85String(%q) succeeded", input)
+		}
+	}
+}
+
+func TestZ85(t *testing.T) {
+	input := []byte{0x86, 0x4f, 0xd2, 0x6f, 0xb5, 0x59, 0xf7, 0x5b}
+	got, err := toZ85String(input)
+	if err != nil || got != "HelloWorld" {
+		t.Errorf("toZ85String(%v) = '%v', '%v', want 'HelloWorld'", input, got, err)
+	}
+	if _, err := toZ85String(input[:3]); !errors.Is(err, ErrInvalidZ85Len) {
+		t.Errorf("toZ85String(%v) = '%v', want '%v'", input[:3], err, ErrInvalidZ85Len)
+	}
+	if _, err := fromZ85String("Hello World"); !errors.Is(err, ErrInvalidZ85Len) {
+		t.Errorf("fromZ85String = '%v', want '%v'", err, ErrInvalidZ85Len)
+	}
+	if _, err := fromZ85String("Hello~orld"); !errors.Is(err, ErrInvalidZ85Char) {
+		t.Errorf("fromZ85String = '%v', want '%v'", err, ErrInvalidZ85Char)
+	}
+}
+
+func TestBase85Inverses(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		bs := make([]byte, rand.Intn(1024))
+		rand.Read(bs)
+		if rand.Intn(2) == 0 && len(bs) >= 8 {
+			copy(bs[4:8], []byte{0, 0, 0, 0})
+		}
+		got, err := fromAscii85String(toAscii85String(bs))
+		if err != nil || !bytes.Equal(got, bs) {
+			t.Fatalf("fromAscii85String(toAscii85String(%v)) = '%v', '%v'", bs, got, err)
+		}
+		bs = bs[:len(bs)/4*4]
+		z, err := toZ85String(bs)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err = fromZ85String(z)
+		if err != nil || !bytes.Equal(got, bs) {
+			t.Fatalf("fromZ85String(toZ85String(%v)) = '%v', '%v'", bs, got, err)
+		}
+	}
+}
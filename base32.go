@@ -0,0 +1,118 @@
+package main
+
+import (
+	"errors"
+	"strings"
+)
+
+type base32 string
+
+const (
+	base32Alphabet    = "ABCDEFGHIJKLMNOPQRSTUVWXYZ234567"
+	base32HexAlphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUV"
+)
+
+var (
+	ErrInvalidB32Len  = errors.New("fromBase32String: invalid input length")
+	ErrInvalidB32Char = errors.New("fromBase32String: invalid input char")
+	ErrInvalidB32Pad  = errors.New("fromBase32String: invalid padding")
+)
+
+// base32Encoding is an RFC 4648 base32 variant. Padded encodings emit '=' up
+// to a multiple of eight symbols and require it when decoding, raw encodings
+// neither emit nor accept it.
+type base32Encoding struct {
+	alphabet string
+	padded   bool
+}
+
+var (
+	stdBase32 = base32Encoding{base32Alphabet, true}
+	hexBase32 = base32Encoding{base32HexAlphabet, true}
+)
+
+func (e base32Encoding) withPadding(padded bool) base32Encoding {
+	e.padded = padded
+	return e
+}
+
+// Number of symbols needed for the last 0 to 4 bytes of a group.
+var base32Tail = [5]int{0, 2, 4, 5, 7}
+
+func (e base32Encoding) encodedLen(n int) int {
+	if e.padded {
+		return (n + 4) / 5 * 8
+	}
+	return n/5*8 + base32Tail[n%5]
+}
+
+func (e base32Encoding) encode(bytes []byte) base32 {
+	buf := make([]byte, e.encodedLen(len(bytes)))
+	for i := 0; i < len(bytes); i += 5 {
+		var group [5]byte
+		n := copy(group[:], bytes[i:])
+		var packed uint64
+		for _, b := range group {
+			packed = packed<<8 | uint64(b)
+		}
+		out := buf[i/5*8:]
+		symbols := 8
+		if n < 5 {
+			symbols = base32Tail[n]
+		}
+		for j := 0; j < 8; j++ {
+			if j < symbols {
+				out[j] = e.alphabet[(packed>>uint(35-5*j))&0x1f]
+			} else if e.padded {
+				out[j] = '='
+			}
+		}
+	}
+	return base32(buf)
+}
+
+func (e base32Encoding) decode(s base32) ([]byte, error) {
+	length := len(s)
+	if e.padded && length%8 != 0 {
+		return nil, lenError("base32", ErrInvalidB32Len, string(s))
+	}
+	n := length
+	for n > 0 && s[n-1] == '=' {
+		n--
+	}
+	pad := length - n
+	rem := n % 8
+	tail := 0 // Bytes in the last partial group
+	for tail < len(base32Tail) && base32Tail[tail] != rem {
+		tail++
+	}
+	switch {
+	case tail == len(base32Tail):
+		return nil, lenError("base32", ErrInvalidB32Len, string(s))
+	case pad > 0 && (!e.padded || (n+pad)%8 != 0):
+		return nil, charError("base32", ErrInvalidB32Pad, string(s), n)
+	}
+	bytes := make([]byte, n/8*5+tail)
+	for i := 0; i < n; i += 8 {
+		var packed uint64
+		for j := 0; j < 8; j++ {
+			var x byte
+			if i+j < n {
+				k := strings.IndexByte(e.alphabet, s[i+j])
+				if k == -1 {
+					if s[i+j] == '=' {
+						return nil, charError("base32", ErrInvalidB32Pad, string(s), i+j)
+					}
+					return nil, charError("base32", ErrInvalidB32Char, string(s), i+j)
+				}
+				x = byte(k)
+			}
+			packed = packed<<5 | uint64(x)
+		}
+		out := bytes[i/8*5:]
+		for j := 0; j < 5 && j < len(out); j++ {
+			out[j] = byte(packed >> uint(32-8*j))
+		}
+	}
+	return bytes, nil
+}
@@ -0,0 +1,67 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestBase32(t *testing.T) {
+	tests := []struct {
+		input    string
+		std, hex base32
+	}{
+		{"", "", ""},
+		{"f", "MY======", "CO======"},
+		{"fo", "MZXQ====", "CPNG===="},
+		{"foo", "MZXW6===", "CPNMU==="},
+		{"foob", "MZXW6YQ=", "CPNMUOG="},
+		{"fooba", "MZXW6YTB", "CPNMUOJ1"},
+		{"foobar", "MZXW6YTBOI======", "CPNMUOJ1E8======"},
+	}
+	for _, test := range tests {
+		if got := stdBase32.encode([]byte(test.input)); got != test.std {
+			t.Errorf("stdBase32.encode(%q) = '%v', want '%v'", test.input, got, test.std)
+		}
+		if got := hexBase32.encode([]byte(test.input)); got != test.hex {
+			t.Errorf("hexBase32.encode(%q) = '%v', want '%v'", test.input, got, test.hex)
+		}
+	}
+}
+
+func TestBase32DecodeErrors(t *testing.T) {
+	tests := []struct {
+		enc     base32Encoding
+		input   base32
+		wanterr error
+	}{
+		{stdBase32, "MZXW6", ErrInvalidB32Len},
+		{stdBase32, "MZX=====", ErrInvalidB32Len},
+		{stdBase32, "MZ=W6===", ErrInvalidB32Pad},
+		{stdBase32, "mzxw6===", ErrInvalidB32Char},
+		{stdBase32.withPadding(false), "MZXW6===", ErrInvalidB32Pad},
+		{stdBase32.withPadding(false), "MZX", ErrInvalidB32Len},
+	}
+	for _, test := range tests {
+		_, err := test.enc.decode(test.input)
+		if !errors.Is(err, test.wanterr) {
+			t.Errorf("decode(%q) = '%v', want '%v'", test.input, err, test.wanterr)
+		}
+	}
+}
+
+func TestBase32Inverses(t *testing.T) {
+	encs := []base32Encoding{stdBase32, hexBase32, stdBase32.withPadding(false), hexBase32.withPadding(false)}
+	for i := 0; i < 1000; i++ {
+		bs := make([]byte, rand.Intn(1024))
+		rand.Read(bs)
+		for _, enc := range encs {
+			s := enc.encode(bs)
+			got, err := enc.decode(s)
+			if err != nil || !bytes.Equal(got, bs) {
+				t.Fatalf("decode(encode(%v)) = '%v', '%v'", bs, got, err)
+			}
+		}
+	}
+}
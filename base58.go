@@ -0,0 +1,107 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"strings"
+)
+
+type base58 string
+
+const base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+
+var (
+	ErrInvalidB58Char     = errors.New("fromBase58String: invalid input char")
+	ErrInvalidB58Len      = errors.New("fromBase58Check: input too short")
+	ErrInvalidB58Checksum = errors.New("fromBase58Check: checksum mismatch")
+)
+
+// Leading zero bytes are encoded as one '1' each, the rest as a big-endian
+// number in base 58.
+func toBase58String(bytes []byte) base58 {
+	zeros := 0
+	for zeros < len(bytes) && bytes[zeros] == 0 {
+		zeros++
+	}
+	// log(256)/log(58) < 1.37, so this is enough room for the digits.
+	digits := make([]byte, 0, len(bytes)*137/100+1)
+	for _, b := range bytes[zeros:] {
+		carry := int(b)
+		for i := range digits {
+			carry += int(digits[i]) << 8
+			digits[i] = byte(carry % 58)
+			carry /= 58
+		}
+		for carry > 0 {
+			digits = append(digits, byte(carry%58))
+			carry /= 58
+		}
+	}
+	buf := make([]byte, zeros+len(digits))
+	for i := 0; i < zeros; i++ {
+		buf[i] = base58Alphabet[0]
+	}
+	for i, d := range digits {
+		buf[len(buf)-1-i] = base58Alphabet[d]
+	}
+	return base58(buf)
+}
+
+func fromBase58String(s base58) ([]byte, error) {
+	zeros := 0
+	for zeros < len(s) && s[zeros] == base58Alphabet[0] {
+		zeros++
+	}
+	// Little-endian base 256 digits of the number.
+	var num []byte
+	for i := zeros; i < len(s); i++ {
+		carry := strings.IndexByte(base58Alphabet, s[i])
+		if carry == -1 {
+			return nil, charError("base58", ErrInvalidB58Char, string(s), i)
+		}
+		for j := range num {
+			carry += int(num[j]) * 58
+			num[j] = byte(carry)
+			carry >>= 8
+		}
+		for carry > 0 {
+			num = append(num, byte(carry))
+			carry >>= 8
+		}
+	}
+	buf := make([]byte, zeros+len(num))
+	for i, b := range num {
+		buf[len(buf)-1-i] = b
+	}
+	return buf, nil
+}
+
+func base58Checksum(payload []byte) []byte {
+	first := sha256.Sum256(payload)
+	second := sha256.Sum256(first[:])
+	return second[:4]
+}
+
+// toBase58Check appends the first four bytes of the double SHA-256 of payload
+// before encoding. Any version prefix is expected to be part of payload.
+func toBase58Check(payload []byte) base58 {
+	buf := make([]byte, len(payload), len(payload)+4)
+	copy(buf, payload)
+	return toBase58String(append(buf, base58Checksum(payload)...))
+}
+
+func fromBase58Check(s base58) ([]byte, error) {
+	buf, err := fromBase58String(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) < 4 {
+		return nil, lenError("base58", ErrInvalidB58Len, string(s))
+	}
+	payload, checksum := buf[:len(buf)-4], buf[len(buf)-4:]
+	if !bytes.Equal(checksum, base58Checksum(payload)) {
+		return nil, ErrInvalidB58Checksum
+	}
+	return payload, nil
+}
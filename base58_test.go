@@ -0,0 +1,77 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+func TestBase58(t *testing.T) {
+	tests := []struct {
+		input hex
+		want  base58
+	}{
+		{"", ""},
+		{"61", "2g"},
+		{"626262", "a3gV"},
+		{"73696d706c792061206c6f6e6720737472696e67", "2cFupjhnEsSn59qHXstmK2ffpLv2"},
+		{"00eb15231dfceb60925886b67d065299925915aeb172c06647", "1NS17iag9jJgTHD1VXjvLCEnZuQ3rJDE9L"},
+		{"bf4f89001e670274dd", "3SEo3LWLoPntC"},
+		{"00000000000000000000", "1111111111"},
+	}
+	for _, test := range tests {
+		bs, err := fromHexString(test.input)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := toBase58String(bs); got != test.want {
+			t.Errorf("toBase58String(%v) = '%v', want '%v'", test.input, got, test.want)
+		}
+		got, err := fromBase58String(test.want)
+		if err != nil || toHexString(got) != test.input {
+			t.Errorf("fromBase58String(%v) = '%v', '%v', want '%v'", test.want, got, err, test.input)
+		}
+	}
+	if _, err := fromBase58String("2g0"); !errors.Is(err, ErrInvalidB58Char) {
+		t.Errorf("fromBase58String(\"2g0\") = '%v', want '%v'", err, ErrInvalidB58Char)
+	}
+}
+
+func TestBase58Check(t *testing.T) {
+	tests := []struct {
+		input   base58
+		want    hex
+		wanterr error
+	}{
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNa", "0062e907b15cbf27d5425399ebf6f0fb50ebb88f18", nil},
+		{"1A1zP1eP5QGefi2DMPTfTL5SLmv7DivfNb", "", ErrInvalidB58Checksum},
+		{"2g", "", ErrInvalidB58Len},
+	}
+	for _, test := range tests {
+		got, err := fromBase58Check(test.input)
+		if !errors.Is(err, test.wanterr) {
+			t.Errorf("fromBase58Check(%v) = '%v', want '%v'", test.input, err, test.wanterr)
+		} else if toHexString(got) != test.want {
+			t.Errorf("fromBase58Check(%v) = '%v', want '%v'", test.input, toHexString(got), test.want)
+		}
+	}
+}
+
+func TestBase58Inverses(t *testing.T) {
+	for i := 0; i < 1000; i++ {
+		bs := make([]byte, rand.Intn(64))
+		rand.Read(bs)
+		for j := 0; j < len(bs) && rand.Intn(2) == 0; j++ {
+			bs[j] = 0
+		}
+		got, err := fromBase58String(toBase58String(bs))
+		if err != nil || !bytes.Equal(got, bs) {
+			t.Fatalf("fromBase58String(toBase58String(%v)) = '%v', '%v'", bs, got, err)
+		}
+		got, err = fromBase58Check(toBase58Check(bs))
+		if err != nil || !bytes.Equal(got, bs) {
+			t.Fatalf("fromBase58Check(toBase58Check(%v)) = '%v', '%v'", bs, got, err)
+		}
+	}
+}
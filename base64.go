@@ -0,0 +1,156 @@
+package main
+
+import "strings"
+
+// base64Encoding is a base64 variant given by its alphabet and padding rules.
+//
+// Padded encodings emit '=' up to a multiple of four symbols, raw encodings
+// emit none. Strict decoding only accepts the canonical encoding: padding
+// exactly as the encoding emits it and zero bits in the last symbol. Lenient
+// decoding accepts input with or without padding and ignores the trailing
+// bits. In both modes '=' is only accepted at the end of the input.
+type base64Encoding struct {
+	alphabet string
+	padded   bool
+	strict   bool
+}
+
+var (
+	stdBase64    = base64Encoding{base64Alphabet, true, true}
+	urlBase64    = base64Encoding{base64URLAlphabet, true, true}
+	rawStdBase64 = base64Encoding{base64Alphabet, false, true}
+	rawURLBase64 = base64Encoding{base64URLAlphabet, false, true}
+)
+
+func (e base64Encoding) withPadding(padded bool) base64Encoding {
+	e.padded = padded
+	return e
+}
+
+func (e base64Encoding) lenient() base64Encoding {
+	e.strict = false
+	return e
+}
+
+// Valid max output is 63, returns 255 on error.
+func (e base64Encoding) value(x byte) byte {
+	i := strings.IndexByte(e.alphabet, x)
+	if i == -1 {
+		return 255
+	}
+	return byte(i)
+}
+
+func (e base64Encoding) encodedLen(n int) int {
+	if e.padded {
+		return (n + 2) / 3 * 4
+	}
+	return n/3*4 + (n%3*4+2)/3
+}
+
+func (e base64Encoding) encode(bytes []byte) base64 {
+	buf := make([]byte, e.encodedLen(len(bytes)))
+	e.encodeTo(buf, bytes)
+	return base64(buf)
+}
+
+// encodeTo writes the encoding of src to dst, which must hold at least
+// e.encodedLen(len(src)) bytes.
+func (e base64Encoding) encodeTo(dst, src []byte) {
+	length := len(src)
+	n := length / 3
+	for i := 0; i < n; i++ {
+		a := int32(src[3*i]) << 16
+		b := int32(src[3*i+1]) << 8
+		c := int32(src[3*i+2])
+		packed := a | b | c
+		dst[4*i] = e.alphabet[(packed>>18)&0x3f]
+		dst[4*i+1] = e.alphabet[(packed>>12)&0x3f]
+		dst[4*i+2] = e.alphabet[(packed>>6)&0x3f]
+		dst[4*i+3] = e.alphabet[packed&0x3f]
+	}
+	switch length % 3 {
+	case 0:
+		break
+	case 1:
+		packed := int32(src[3*n]) << 16
+		dst[4*n] = e.alphabet[(packed>>18)&0x3f]
+		dst[4*n+1] = e.alphabet[(packed>>12)&0x3f]
+		if e.padded {
+			dst[4*n+2] = '='
+			dst[4*n+3] = '='
+		}
+	case 2:
+		packed := (int32(src[3*n]) << 16) | (int32(src[3*n+1]) << 8)
+		dst[4*n] = e.alphabet[(packed>>18)&0x3f]
+		dst[4*n+1] = e.alphabet[(packed>>12)&0x3f]
+		dst[4*n+2] = e.alphabet[(packed>>6)&0x3f]
+		if e.padded {
+			dst[4*n+3] = '='
+		}
+	default:
+		panic("unreachable")
+	}
+}
+
+func (e base64Encoding) decode(s base64) ([]byte, error) {
+	length := len(s)
+	if e.strict && e.padded && length%4 != 0 {
+		return nil, lenError("base64", ErrInvalidB64Len, string(s))
+	}
+	n := length
+	for n > 0 && length-n < 2 && s[n-1] == '=' {
+		n--
+	}
+	pad := length - n
+	rem := n % 4
+	if rem == 1 {
+		return nil, lenError("base64", ErrInvalidB64Len, string(s))
+	}
+	switch {
+	case pad > 0 && (n+pad)%4 != 0:
+		return nil, charError("base64", ErrInvalidB64Pad, string(s), n)
+	case pad > 0 && e.strict && !e.padded:
+		return nil, charError("base64", ErrInvalidB64Pad, string(s), n)
+	}
+	chunks := n / 4
+	nbytes := 3*chunks + rem*3/4
+	bytes := make([]byte, nbytes)
+	var quad [4]byte
+	for i := 0; i < n; i += 4 {
+		k := copy(quad[:], s[i:n])
+		for j := 0; j < 4; j++ {
+			if j >= k {
+				quad[j] = 0
+				continue
+			}
+			quad[j] = e.value(quad[j])
+			if quad[j] > 63 {
+				if s[i+j] == '=' {
+					return nil, charError("base64", ErrInvalidB64Pad, string(s), i+j)
+				}
+				return nil, charError("base64", ErrInvalidB64Char, string(s), i+j)
+			}
+		}
+		packed := (int32(quad[0]) << 18) | (int32(quad[1]) << 12) | (int32(quad[2]) << 6) | int32(quad[3])
+		if k < 4 && e.strict && packed&trailingBitsMask(k) != 0 {
+			return nil, charError("base64", ErrInvalidB64Bits, string(s), n-1)
+		}
+		out := bytes[3*(i/4):]
+		out[0] = byte(packed >> 16)
+		if k > 2 {
+			out[1] = byte(packed >> 8)
+		}
+		if k > 3 {
+			out[2] = byte(packed)
+		}
+	}
+	return bytes, nil
+}
+
+// trailingBitsMask masks the bits of a packed quad that are left over when
+// only the first k symbols are present.
+func trailingBitsMask(k int) int32 {
+	unused := uint(8 - 2*k)
+	return int32(1<<unused-1) << uint(24-6*k)
+}
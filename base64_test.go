@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestBase64Encodings(t *testing.T) {
+	input := []byte{0xfb, 0xff, 0xbf, 0x4d}
+	tests := []struct {
+		enc  base64Encoding
+		want base64
+	}{
+		{stdBase64, "+/+/TQ=="},
+		{urlBase64, "-_-_TQ=="},
+		{rawStdBase64, "+/+/TQ"},
+		{rawURLBase64, "-_-_TQ"},
+	}
+	for _, test := range tests {
+		if got := test.enc.encode(input); got != test.want {
+			t.Errorf("encode(%v) = '%v', want '%v'", input, got, test.want)
+		}
+	}
+}
+
+func TestBase64Decode(t *testing.T) {
+	tests := []struct {
+		enc     base64Encoding
+		input   base64
+		want    hex
+		wanterr error
+	}{
+		{stdBase64, "TWE=", "4d61", nil},
+		{stdBase64, "TWE", "", ErrInvalidB64Len},
+		{stdBase64, "TW=u", "", ErrInvalidB64Pad},
+		{stdBase64, "TQ==TQ==", "", ErrInvalidB64Pad},
+		{stdBase64, "T===", "", ErrInvalidB64Pad},
+		{stdBase64, "TWF=", "", ErrInvalidB64Bits},
+		{stdBase64, "TR==", "", ErrInvalidB64Bits},
+		{stdBase64, "-_-_", "", ErrInvalidB64Char},
+		{urlBase64, "-_-_", "fbffbf", nil},
+		{rawURLBase64, "TWE", "4d61", nil},
+		{rawURLBase64, "TWE=", "", ErrInvalidB64Pad},
+		{rawURLBase64, "TWFuT", "", ErrInvalidB64Len},
+		{stdBase64.lenient(), "TWF", "4d61", nil},
+		{stdBase64.lenient(), "TR==", "4d", nil},
+		{stdBase64.lenient(), "TW=", "", ErrInvalidB64Pad},
+		{rawURLBase64.lenient(), "TWE=", "4d61", nil},
+		{rawURLBase64.lenient(), "TW=u", "", ErrInvalidB64Pad},
+	}
+	for _, test := range tests {
+		bs, err := test.enc.decode(test.input)
+		if !errors.Is(err, test.wanterr) {
+			t.Errorf("decode(%q) = '%v', want '%v'", test.input, err, test.wanterr)
+		} else if got := toHexString(bs); got != test.want {
+			t.Errorf("decode(%q) = '%v', want '%v'", test.input, got, test.want)
+		}
+
+		// The streaming decoder follows the same rules.
+		bs, err = ioutil.ReadAll(newBase64Decoder(test.enc, strings.NewReader(string(test.input))))
+		if test.wanterr != nil && err == nil || test.wanterr == nil && toHexString(bs) != test.want {
+			t.Errorf("newBase64Decoder(%q) = '%v', '%v', want '%v'", test.input, bs, err, test.wanterr)
+		}
+	}
+}
+
+func TestBase64EncodingInverses(t *testing.T) {
+	encs := []base64Encoding{stdBase64, urlBase64, rawStdBase64, rawURLBase64}
+	for i := 0; i < 1000; i++ {
+		bs := make([]byte, rand.Intn(64))
+		rand.Read(bs)
+		for _, enc := range encs {
+			s := enc.encode(bs)
+			if len(s) != enc.encodedLen(len(bs)) {
+				t.Errorf("encodedLen(%d) = %d, want %d", len(bs), enc.encodedLen(len(bs)), len(s))
+			}
+			got, err := enc.decode(s)
+			if err != nil || !bytes.Equal(got, bs) {
+				t.Errorf("decode(encode(%v)) = '%v', '%v'", bs, got, err)
+			}
+			got, err = enc.lenient().withPadding(!enc.padded).decode(s)
+			if err != nil || !bytes.Equal(got, bs) {
+				t.Errorf("lenient decode(encode(%v)) = '%v', '%v'", bs, got, err)
+			}
+		}
+	}
+}
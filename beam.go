@@ -0,0 +1,128 @@
+package main
+
+import (
+	"context"
+	"sort"
+)
+
+type keyAlternative struct {
+	key   byte
+	score float32 // Score of the whole plaintext with this key byte
+}
+
+type beamResult struct {
+	crackedKey // Scored with the n-gram model
+	// For each key position, the column's candidate bytes in place of the
+	// best key's byte, best first.
+	alternatives [][]keyAlternative
+}
+
+// beamKey is a partial key in the beam. ngram is the log probability of the
+// plaintext it reveals, and column the sum of its bytes' column scores.
+type beamKey struct {
+	key    []byte
+	ngram  float32
+	column float32
+}
+
+// better orders partial keys by n-gram likelihood, falling back on column
+// scores while too few columns are known to form n-grams.
+func (b *beamKey) better(o *beamKey) bool {
+	if b.ngram != o.ngram {
+		return b.ngram > o.ngram
+	}
+	return b.column < o.column
+}
+
+// crackKeyBeamSearch cracks a repeating XOR key of keySize bytes with the
+// n-gram model m, so that adjacent plaintext bytes are scored together
+// rather than column by column. Every column gets opts.columnKeys candidate
+// bytes from s, and the key is built one position at a time keeping the
+// opts.beamWidth partial keys whose plaintext so far is most likely. A nil m
+// means the English model, englishNgrams.
+func crackKeyBeamSearch(ctx context.Context, input []byte, keySize int, s scorer, m *ngramModel, opts xorOptions) (beamResult, error) {
+	if keySize < 1 {
+		return beamResult{}, ErrInvalidKeySize
+	}
+	opts = opts.withDefaults()
+	if m == nil {
+		m = englishNgrams()
+	}
+	transpose := transposeColumns(input, keySize)
+	columns := make([][]xorCandidate, keySize)
+	errs := make([]error, keySize)
+	err := parallelFor(ctx, keySize, opts.workers, func(i int) {
+		res, err := crackSingleCharXorAllowed(transpose[i], s, opts.keyBytes, opts.plaintextBytes, opts.columnKeys)
+		if err != nil {
+			errs[i] = &keyColumnError{keySize, i, err}
+			return
+		}
+		columns[i] = res.candidates
+	})
+	if err != nil {
+		return beamResult{}, err
+	}
+	for _, err := range errs {
+		if err != nil {
+			return beamResult{}, err
+		}
+	}
+
+	beam := []beamKey{{}}
+	for i, candidates := range columns {
+		next := make([]beamKey, len(beam)*len(candidates))
+		err := parallelFor(ctx, len(next), opts.workers, func(j int) {
+			b, c := &beam[j/len(candidates)], candidates[j%len(candidates)]
+			key := append(append(make([]byte, 0, i+1), b.key...), c.key)
+			next[j] = beamKey{key, prefixLogProb(input, key, keySize, m), b.column + c.score}
+		})
+		if err != nil {
+			return beamResult{}, err
+		}
+		sort.SliceStable(next, func(i, j int) bool { return next[i].better(&next[j]) })
+		if len(next) > opts.beamWidth {
+			next = next[:opts.beamWidth]
+		}
+		beam = next
+	}
+
+	var res beamResult
+	key := beam[0].key
+	res.key = key
+	res.plaintext, _ = repeatingKeyXor(input, key)
+	res.score = m.score(res.plaintext)
+	res.language = detectedLanguage(s, res.plaintext)
+	res.alternatives = make([][]keyAlternative, keySize)
+	err = parallelFor(ctx, keySize, opts.workers, func(i int) {
+		alt := append([]byte(nil), key...)
+		plaintext := make([]byte, len(input))
+		for _, c := range columns[i] {
+			alt[i] = c.key
+			repeatingKeyXorInto(plaintext, input, alt)
+			res.alternatives[i] = append(res.alternatives[i], keyAlternative{c.key, m.score(plaintext)})
+		}
+		sort.SliceStable(res.alternatives[i], func(j, k int) bool {
+			return res.alternatives[i][j].score < res.alternatives[i][k].score
+		})
+	})
+	if err != nil {
+		return beamResult{}, err
+	}
+	return res, nil
+}
+
+// prefixLogProb is the log probability under m of the plaintext that the
+// first len(key) bytes of a key of keySize bytes reveal in each block.
+func prefixLogProb(input, key []byte, keySize int, m *ngramModel) float32 {
+	var sum float32
+	buf := make([]byte, len(key))
+	for start := 0; start < len(input); start += keySize {
+		block := input[start:min(start+len(key), len(input))]
+		for j, c := range block {
+			buf[j] = c ^ key[j]
+		}
+		p, _ := m.logProb(buf[:len(block)])
+		sum += p
+	}
+	return sum
+}
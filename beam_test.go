@@ -0,0 +1,93 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+)
+
+func TestCrackKeyBeamSearch(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := englishNgrams()
+	// 60 bytes under a 12 byte key leave five bytes per column, too few for
+	// column statistics to go on.
+	const n, keySize, trials = 60, 12, 20
+	rng := rand.New(rand.NewSource(1))
+	columnOK, beamOK := 0, 0
+	for i := 0; i < trials; i++ {
+		key := make([]byte, keySize)
+		rng.Read(key)
+		offset := rng.Intn(len(corpus) - n)
+		input, _ := repeatingKeyXor(corpus[offset:offset+n], key)
+		columnKey, err := crackKeyAssumingKeySize(context.Background(), input, keySize, englishBytes, xorOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		res, err := crackKeyBeamSearch(context.Background(), input, keySize, englishBytes, m, xorOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if bytes.Equal(columnKey, key) {
+			columnOK++
+		}
+		if bytes.Equal(res.key, key) {
+			beamOK++
+			if !bytes.Equal(res.plaintext, corpus[offset:offset+n]) {
+				t.Errorf("got plaintext %q", res.plaintext)
+			}
+		}
+	}
+	if beamOK < trials/2 || beamOK <= columnOK {
+		t.Errorf("beam search cracked %d of %d keys, column by column %d", beamOK, trials, columnOK)
+	}
+}
+
+func TestCrackKeyBeamSearchAlternatives(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := englishNgrams()
+	key := []byte("SECRET")
+	input, _ := repeatingKeyXor(corpus[:90], key)
+	res, err := crackKeyBeamSearch(context.Background(), input, len(key), englishBytes, m, xorOptions{columnKeys: 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.key, key) {
+		t.Fatalf("got key %q, want %q", res.key, key)
+	}
+	if len(res.alternatives) != len(key) {
+		t.Fatalf("got %d positions of alternatives, want %d", len(res.alternatives), len(key))
+	}
+	for i, alts := range res.alternatives {
+		if len(alts) != 4 {
+			t.Errorf("position %d: got %d alternatives, want 4", i, len(alts))
+			continue
+		}
+		// Any other byte makes the plaintext less likely.
+		if alts[0].key != key[i] || alts[0].score != res.score {
+			t.Errorf("position %d: best alternative %v, want key %q scoring %v", i, alts[0], key[i], res.score)
+		}
+		for j := 1; j < len(alts); j++ {
+			if alts[j].score < alts[j-1].score {
+				t.Errorf("position %d: alternatives out of order: %v", i, alts)
+			}
+		}
+	}
+}
+
+func TestCrackKeyBeamSearchNoKeyByte(t *testing.T) {
+	input := []byte{0x00, 0x00, 0x00, 0x01, 0x02, 0xff}
+	_, err := crackKeyBeamSearch(context.Background(), input, 3, l1Scorer{}, nil, xorOptions{plaintextBytes: hexBytes})
+	var cerr *keyColumnError
+	if !errors.As(err, &cerr) || !errors.Is(err, ErrNoKeyByte) || cerr.column != 2 {
+		t.Errorf("got '%v'", err)
+	}
+}
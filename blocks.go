@@ -0,0 +1,139 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+const ansiReset = "\x1b[0m"
+
+// Colours cycled through for block tags, and the one used for differences.
+var (
+	ansiTagColors = []string{"\x1b[31m", "\x1b[32m", "\x1b[33m", "\x1b[34m", "\x1b[35m", "\x1b[36m"}
+	ansiDiffColor = "\x1b[1;31m"
+)
+
+func splitBlocks(bs []byte, blockSize int) [][]byte {
+	if blockSize <= 0 {
+		panic("splitBlocks: invalid block size")
+	}
+	blocks := make([][]byte, 0, (len(bs)+blockSize-1)/blockSize)
+	for i := 0; i < len(bs); i += blockSize {
+		blocks = append(blocks, bs[i:min(i+blockSize, len(bs))])
+	}
+	return blocks
+}
+
+// blockTags numbers the blocks of bs that occur more than once, in order of
+// first appearance. Blocks that are unique get -1.
+func blockTags(bs []byte, blockSize int) []int {
+	blocks := splitBlocks(bs, blockSize)
+	count := make(map[string]int)
+	for _, b := range blocks {
+		count[string(b)]++
+	}
+	tags := make([]int, len(blocks))
+	seen := make(map[string]int)
+	for i, b := range blocks {
+		tags[i] = -1
+		if count[string(b)] < 2 {
+			continue
+		}
+		tag, ok := seen[string(b)]
+		if !ok {
+			tag = len(seen)
+			seen[string(b)] = tag
+		}
+		tags[i] = tag
+	}
+	return tags
+}
+
+// Tags are A to Z, then AA, AB and so on.
+func tagName(tag int) string {
+	// Bijective base 26: A is 1 and Z is 26, so there is no zero digit.
+	name := ""
+	for n := tag + 1; n > 0; n = (n - 1) / 26 {
+		name = string(rune('A'+(n-1)%26)) + name
+	}
+	return name
+}
+
+// renderBlocks prints bs one block per row with its offset. Repeated blocks
+// get the same tag at the end of the row, and the same colour if color is set,
+// which makes ECB stand out.
+func renderBlocks(bs []byte, blockSize int, color bool) string {
+	var b strings.Builder
+	tags := blockTags(bs, blockSize)
+	width := 2 * blockSize
+	for i, block := range splitBlocks(bs, blockSize) {
+		h := string(toHexString(block))
+		pad := strings.Repeat(" ", width-len(h))
+		fmt.Fprintf(&b, "%08x  ", i*blockSize)
+		switch tag := tags[i]; {
+		case tag < 0:
+			fmt.Fprintf(&b, "%s\n", h)
+		case color:
+			c := ansiTagColors[tag%len(ansiTagColors)]
+			fmt.Fprintf(&b, "%s%s%s%s  %s%s%s\n", c, h, ansiReset, pad, c, tagName(tag), ansiReset)
+		default:
+			fmt.Fprintf(&b, "%s%s  %s\n", h, pad, tagName(tag))
+		}
+	}
+	return b.String()
+}
+
+// diffBlocks prints x and y side by side one block per row, marking rows that
+// differ with '!'. The differing bytes are coloured if color is set, otherwise
+// they are pointed out with '^' on the line below.
+func diffBlocks(x, y []byte, blockSize int, color bool) string {
+	var b strings.Builder
+	xs, ys := splitBlocks(x, blockSize), splitBlocks(y, blockSize)
+	for i := 0; i < len(xs) || i < len(ys); i++ {
+		var xb, yb []byte
+		if i < len(xs) {
+			xb = xs[i]
+		}
+		if i < len(ys) {
+			yb = ys[i]
+		}
+		var xh, yh, marks strings.Builder
+		same := len(xb) == len(yb)
+		for j := 0; j < blockSize; j++ {
+			inX, inY := j < len(xb), j < len(yb)
+			diff := inX != inY || inX && xb[j] != yb[j]
+			if diff {
+				same = false
+			}
+			for _, col := range []struct {
+				w     *strings.Builder
+				block []byte
+				ok    bool
+			}{{&xh, xb, inX}, {&yh, yb, inY}} {
+				switch {
+				case !col.ok:
+					col.w.WriteString("  ")
+				case diff && color:
+					fmt.Fprintf(col.w, "%s%s%s", ansiDiffColor, toHexString(col.block[j:j+1]), ansiReset)
+				default:
+					col.w.WriteString(string(toHexString(col.block[j : j+1])))
+				}
+			}
+			if diff {
+				marks.WriteString("^^")
+			} else {
+				marks.WriteString("  ")
+			}
+		}
+		mark := '='
+		if !same {
+			mark = '!'
+		}
+		fmt.Fprintf(&b, "%08x  %s  %s  %c\n", i*blockSize, xh.String(), yh.String(), mark)
+		if !same && !color {
+			m := marks.String()
+			fmt.Fprintln(&b, strings.TrimRight(fmt.Sprintf("%8s  %s  %s", "", m, m), " "))
+		}
+	}
+	return b.String()
+}
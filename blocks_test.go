@@ -0,0 +1,59 @@
+package main
+
+import (
+	"cryptopals/aes"
+	"strings"
+	"testing"
+)
+
+func TestRenderBlocks(t *testing.T) {
+	key := []byte("YELLOW SUBMARINE")
+	input := []byte("YELLOW SUBMARINEsome other text!YELLOW SUBMARINE")
+	enc, err := aes.EcbEncrypt128(key, input)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSuffix(renderBlocks(enc, 16, false), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("renderBlocks gave %d rows, want 3", len(lines))
+	}
+	if !strings.HasSuffix(lines[0], "  A") || !strings.HasSuffix(lines[2], "  A") ||
+		!strings.HasPrefix(lines[2], "00000020  "+string(toHexString(enc[32:]))) {
+		t.Errorf("renderBlocks did not tag the repeated block:\n%s", strings.Join(lines, "\n"))
+	}
+	if strings.Contains(lines[1], "A") && !strings.Contains(string(toHexString(enc[16:32])), "A") {
+		t.Errorf("renderBlocks tagged a unique block: %s", lines[1])
+	}
+	colored := renderBlocks(enc, 16, true)
+	if !strings.Contains(colored, ansiTagColors[0]) {
+		t.Errorf("renderBlocks with color has no ANSI codes")
+	}
+}
+
+func TestBlockTags(t *testing.T) {
+	got := blockTags([]byte("aabbaaccbbdd"), 2)
+	want := []int{0, 1, 0, -1, 1, -1}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("blockTags = %v, want %v", got, want)
+		}
+	}
+	for tag, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 675: "YZ", 676: "ZA", 701: "ZZ", 702: "AAA"} {
+		if got := tagName(tag); got != want {
+			t.Errorf("tagName(%d) = %s, want %s", tag, got, want)
+		}
+	}
+}
+
+func TestDiffBlocks(t *testing.T) {
+	x := []byte("0123456789abcdef")
+	y := []byte("0123456789abcdeF!")
+	want := "00000000  3031323334  3031323334  =\n" +
+		"00000005  3536373839  3536373839  =\n" +
+		"0000000a  6162636465  6162636465  =\n" +
+		"0000000f  66          4621        !\n" +
+		"          ^^^^        ^^^^\n"
+	if got := diffBlocks(x, y, 5, false); got != want {
+		t.Errorf("diffBlocks =\n%s\nwant\n%s", got, want)
+	}
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"io/ioutil"
+	"math"
+)
+
+// byteModel is a scorer that knows the probability of every byte value, not
+// just lowercase letters. The score is the negated mean log probability of the
+// input. With fold set, uppercase letters count as their lowercase versions,
+// both when training and scoring.
+type byteModel struct {
+	fold bool
+	logp [256]float32
+}
+
+// Pseudo-counts added to every byte value so that bytes missing from the
+// corpus get a probability. Printable bytes that weren't seen are far more
+// likely in real text than control bytes.
+const (
+	bytePseudoPrintable = 0.5
+	bytePseudoOther     = 0.01
+)
+
+// englishBytes is trained from englishByteCounts and englishBytesFolded the
+// same with case folding.
+var (
+	englishBytes       = newByteModel(englishByteCounts, false)
+	englishBytesFolded = newByteModel(foldByteCounts(englishByteCounts), true)
+)
+
+func countBytes(corpus []byte) [256]uint32 {
+	var counts [256]uint32
+	for _, c := range corpus {
+		counts[c]++
+	}
+	return counts
+}
+
+// foldByteCounts moves the counts of uppercase letters to lowercase.
+func foldByteCounts(counts [256]uint32) [256]uint32 {
+	for c := 'A'; c <= 'Z'; c++ {
+		counts[c+'a'-'A'] += counts[c]
+		counts[c] = 0
+	}
+	return counts
+}
+
+// newByteModel turns byte counts from a corpus into a model. The counts must
+// already be folded if fold is set.
+func newByteModel(counts [256]uint32, fold bool) *byteModel {
+	var pseudo [256]float64
+	var total float64
+	for c, n := range counts {
+		switch {
+		case fold && foldLetter(byte(c)) != 0 && foldLetter(byte(c)) != byte(c):
+			// Scored as lowercase.
+		case isPrintable(byte(c)):
+			pseudo[c] = bytePseudoPrintable
+		default:
+			pseudo[c] = bytePseudoOther
+		}
+		total += float64(n) + pseudo[c]
+	}
+	m := &byteModel{fold: fold}
+	for c, n := range counts {
+		m.logp[c] = float32(math.Log((float64(n) + pseudo[c]) / total))
+	}
+	if fold {
+		for c := 'A'; c <= 'Z'; c++ {
+			m.logp[c] = m.logp[c+'a'-'A']
+		}
+	}
+	return m
+}
+
+func trainByteModel(corpus []byte, fold bool) *byteModel {
+	counts := countBytes(corpus)
+	if fold {
+		counts = foldByteCounts(counts)
+	}
+	return newByteModel(counts, fold)
+}
+
+func trainByteModelFile(filename string, fold bool) (*byteModel, error) {
+	corpus, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return trainByteModel(corpus, fold), nil
+}
+
+func (m *byteModel) score(bytes []byte) float32 {
+	return m.scoreHistogram(byteHistogram(bytes), 0)
+}
+
+func (m *byteModel) scoreHistogram(h *histogram, key byte) float32 {
+	return meanLogProb(h, key, &m.logp)
+}
@@ -0,0 +1,39 @@
+// Code generated by go test -run TestEnglishByteCounts -update. DO NOT EDIT.
+
+package main
+
+// englishByteCounts is how many times each byte occurs in data/corpus.txt.
+var englishByteCounts = [256]uint32{
+	0, 0, 0, 0, 0, 0, 0, 0, // 0x00
+	0, 0, 95, 0, 0, 0, 0, 0, // 0x08
+	0, 0, 0, 0, 0, 0, 0, 0, // 0x10
+	0, 0, 0, 0, 0, 0, 0, 0, // 0x18
+	3136, 17, 54, 0, 0, 0, 0, 13, // 0x20
+	5, 5, 0, 0, 248, 19, 118, 0, // 0x28
+	0, 0, 0, 0, 0, 0, 0, 0, // 0x30
+	0, 0, 6, 21, 0, 0, 0, 12, // 0x38
+	0, 19, 15, 8, 7, 6, 6, 12, // 0x40
+	17, 57, 0, 0, 15, 24, 9, 7, // 0x48
+	6, 0, 8, 14, 24, 0, 0, 18, // 0x50
+	0, 5, 0, 0, 0, 0, 0, 0, // 0x58
+	0, 1045, 188, 308, 541, 1737, 338, 278, // 0x60
+	863, 911, 18, 91, 514, 295, 949, 1044, // 0x68
+	206, 16, 810, 853, 1256, 364, 169, 332, // 0x70
+	16, 248, 15, 0, 0, 0, 0, 0, // 0x78
+	0, 0, 0, 0, 0, 0, 0, 0, // 0x80
+	0, 0, 0, 0, 0, 0, 0, 0, // 0x88
+	0, 0, 0, 0, 0, 0, 0, 0, // 0x90
+	0, 0, 0, 0, 0, 0, 0, 0, // 0x98
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xa0
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xa8
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xb0
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xb8
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xc0
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xc8
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xd0
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xd8
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xe0
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xe8
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xf0
+	0, 0, 0, 0, 0, 0, 0, 0, // 0xf8
+}
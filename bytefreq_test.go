@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"testing"
+)
+
+const englishByteCountsFile = "bytefreq_table.go"
+
+func renderByteCounts(counts [256]uint32) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by go test -run TestEnglishByteCounts -update. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package main")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// englishByteCounts is how many times each byte occurs in data/corpus.txt.")
+	fmt.Fprintln(&b, "var englishByteCounts = [256]uint32{")
+	for i := 0; i < 256; i += 8 {
+		for j := i; j < i+8; j++ {
+			fmt.Fprintf(&b, "%d, ", counts[j])
+		}
+		fmt.Fprintf(&b, "// %#02x\n", i)
+	}
+	fmt.Fprintln(&b, "}")
+	return format.Source(b.Bytes())
+}
+
+func TestEnglishByteCounts(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	counts := countBytes(corpus)
+	src, err := renderByteCounts(counts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		if err := ioutil.WriteFile(englishByteCountsFile, src, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if counts != englishByteCounts {
+		t.Fatalf("%s is stale, run go test -run TestEnglishByteCounts -update", englishByteCountsFile)
+	}
+}
+
+func TestByteModelFolding(t *testing.T) {
+	upper, lower := []byte("THE END"), []byte("the end")
+	if englishBytesFolded.score(upper) != englishBytesFolded.score(lower) {
+		t.Errorf("folded: score(%q) != score(%q)", upper, lower)
+	}
+	if englishBytes.score(upper) <= englishBytes.score(lower) {
+		t.Errorf("score(%q) = %v, want more than score(%q) = %v",
+			upper, englishBytes.score(upper), lower, englishBytes.score(lower))
+	}
+	trained := trainByteModel([]byte("Hello, World\n"), true)
+	if trained.logp['H'] != trained.logp['h'] || trained.logp['h'] <= trained.logp['x'] {
+		t.Errorf("trained folded model: logp['H'] = %v, logp['h'] = %v, logp['x'] = %v",
+			trained.logp['H'], trained.logp['h'], trained.logp['x'])
+	}
+}
+
+func TestByteModelPenalizesControlBytes(t *testing.T) {
+	control := []byte{0x01, 0x02, 0x03, 0x1b, 0x7f, 0x00}
+	noLower := []byte("#$%&*@")
+	for _, m := range []*byteModel{englishBytes, englishBytesFolded} {
+		if m.score(control) <= m.score(noLower) {
+			t.Errorf("fold = %v: score(control) = %v, score(%q) = %v",
+				m.fold, m.score(control), noLower, m.score(noLower))
+		}
+	}
+}
+
+// Ranking the candidates of challenge 6 by how their plaintexts score picks
+// the right key without knowing its index.
+func TestByteModelRanksChallenge1_6(t *testing.T) {
+	input, _, err := loadFile("data/6.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, m := range []*byteModel{englishBytes, englishBytesFolded} {
+		candidates, err := findRepeatingKeyXorCandidates(context.Background(), input, m, xorOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		best := candidates[0].key
+		bestPlaintext, _ := repeatingKeyXor(input, best)
+		for _, c := range candidates {
+			if plaintext, _ := repeatingKeyXor(input, c.key); m.score(plaintext) < m.score(bestPlaintext) {
+				best, bestPlaintext = c.key, plaintext
+			}
+		}
+		if string(best) != "Terminator X: Bring the noise" {
+			t.Errorf("fold = %v: best is %q", m.fold, best)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+)
+
+// byteSet is a set of byte values. A nil *byteSet allows every byte.
+type byteSet [256]bool
+
+var ErrNoKeyByte = errors.New("crackSingleCharXorAllowed: no key byte fits")
+
+// Sets for common alphabets.
+var (
+	printableBytes = func() *byteSet {
+		var set byteSet
+		for c := 0; c < 256; c++ {
+			set[c] = isPrintable(byte(c))
+		}
+		return &set
+	}()
+	alphanumericBytes = newByteSet("0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz")
+	hexBytes          = newByteSet("0123456789abcdefABCDEF")
+	base64Bytes       = newByteSet(base64Alphabet + "=")
+	base64URLBytes    = newByteSet(base64URLAlphabet + "=")
+)
+
+func newByteSet(chars string) *byteSet {
+	var set byteSet
+	for i := 0; i < len(chars); i++ {
+		set[chars[i]] = true
+	}
+	return &set
+}
+
+func (set *byteSet) allows(c byte) bool {
+	return set == nil || set[c]
+}
+
+// allowsXored reports whether every byte of values XORed with key is in set.
+func (set *byteSet) allowsXored(values []byte, key byte) bool {
+	if set == nil {
+		return true
+	}
+	for _, c := range values {
+		if !set[c^key] {
+			return false
+		}
+	}
+	return true
+}
+
+// keyColumnError tells which column of a repeating XOR key could not be
+// cracked.
+type keyColumnError struct {
+	keySize, column int
+	err             error
+}
+
+func (e *keyColumnError) Error() string {
+	return fmt.Sprintf("%v for column %d of key size %d", e.err, e.column, e.keySize)
+}
+
+func (e *keyColumnError) Unwrap() error {
+	return e.err
+}
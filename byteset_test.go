@@ -0,0 +1,75 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"testing"
+)
+
+func TestCrackSingleCharXorAllowed(t *testing.T) {
+	plaintext := []byte(toHexString([]byte("hex digits only leave a few keys")))
+	input, _ := repeatingKeyXor(plaintext, []byte{'k'})
+	all := crackSingleCharXorRanked(input, l1Scorer{}, 0)
+	res, err := crackSingleCharXorAllowed(input, l1Scorer{}, nil, hexBytes, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.candidates) >= len(all.candidates) {
+		t.Errorf("%d candidates left of %d", len(res.candidates), len(all.candidates))
+	}
+	found := false
+	for _, c := range res.candidates {
+		if !hexBytes.allowsXored(c.plaintext, 0) {
+			t.Errorf("key %#02x gives %q", c.key, c.plaintext)
+		}
+		found = found || c.key == 'k'
+	}
+	if !found {
+		t.Error("right key pruned")
+	}
+	res, err = crackSingleCharXorAllowed(input, l1Scorer{}, newByteSet("k"), hexBytes, 0)
+	if err != nil || len(res.candidates) != 1 || res.candidates[0].key != 'k' || res.confidence != 1 {
+		t.Errorf("got %v, '%v'", res.candidates, err)
+	}
+	if _, err := crackSingleCharXorAllowed(input, l1Scorer{}, newByteSet("j"), hexBytes, 0); !errors.Is(err, ErrNoKeyByte) {
+		t.Errorf("got '%v', want '%v'", err, ErrNoKeyByte)
+	}
+}
+
+func TestCrackKeyAssumingKeySizeNoKeyByte(t *testing.T) {
+	input := []byte{0x00, 0x00, 0x00, 0x01, 0x02, 0xff}
+	// Column 2 holds 0x00 and 0xff, which no key turns into hex digits both.
+	_, err := crackKeyAssumingKeySize(context.Background(), input, 3, l1Scorer{}, xorOptions{plaintextBytes: hexBytes})
+	var cerr *keyColumnError
+	if !errors.As(err, &cerr) || !errors.Is(err, ErrNoKeyByte) || cerr.column != 2 || cerr.keySize != 3 {
+		t.Errorf("got '%v'", err)
+	}
+}
+
+func TestCrackKeyAssumingKeySizeBase64(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := []byte(toBase64String(corpus[:3000]))
+	key := []byte("K3y!x")
+	input, _ := repeatingKeyXor(plaintext, key)
+	// Letter frequencies mean nothing for base64, but few keys leave every
+	// byte of a column in the alphabet.
+	opts := xorOptions{keyBytes: printableBytes, plaintextBytes: base64Bytes}
+	got, err := crackKeyAssumingKeySize(context.Background(), input, len(key), l1Scorer{}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(got) != string(key) {
+		t.Errorf("key = %q, want %q", got, key)
+	}
+	unconstrained, err := crackKeyAssumingKeySize(context.Background(), input, len(key), l1Scorer{}, xorOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(unconstrained) == string(key) {
+		t.Errorf("key found without constraints too")
+	}
+}
@@ -0,0 +1,350 @@
+package main
+
+import (
+	"errors"
+	"math"
+	"sort"
+)
+
+var (
+	ErrInvalidLetterKey = errors.New("classical cipher: key must be one or more letters")
+	ErrInvalidAffineKey = errors.New("affine: a must be coprime with 26")
+)
+
+// classicalKeySizes is how many key sizes, ranked by index of coincidence,
+// crackVigenere and crackBeaufort crack keys for.
+const classicalKeySizes = 3
+
+// The classical ciphers work on letters only. Other bytes are passed through
+// and don't use up any key, and letters keep their case.
+
+// classicalCandidate is a cracked key for a cipher whose key is letters. A
+// Caesar shift is the single letter that 'a' is shifted to.
+type classicalCandidate struct {
+	key       []byte // Lowercase letters
+	score     float32
+	plaintext []byte
+}
+
+type affineKey struct {
+	a, b int // A letter x is encrypted as a*x + b
+}
+
+type affineCandidate struct {
+	key       affineKey
+	score     float32
+	plaintext []byte
+}
+
+// englishLetterIC is the index of coincidence of letters in English text.
+var englishLetterIC = func() float64 {
+	var ic float64
+	for _, f := range letterFreqs {
+		ic += float64(f) * float64(f)
+	}
+	return ic
+}()
+
+func mod26(x int) int {
+	if x %= 26; x < 0 {
+		x += 26
+	}
+	return x
+}
+
+// mapLetters replaces every letter of text by fn of its place in the alphabet.
+// i counts letters only.
+func mapLetters(text []byte, fn func(i, x int) int) []byte {
+	out := make([]byte, len(text))
+	i := 0
+	for j, c := range text {
+		l := foldLetter(c)
+		if l == 0 {
+			out[j] = c
+			continue
+		}
+		y := byte(mod26(fn(i, int(l-'a'))))
+		if c < 'a' {
+			out[j] = 'A' + y
+		} else {
+			out[j] = 'a' + y
+		}
+		i++
+	}
+	return out
+}
+
+// letterValues returns the places in the alphabet of the letters of text.
+func letterValues(text []byte) []byte {
+	var values []byte
+	for _, c := range text {
+		if l := foldLetter(c); l != 0 {
+			values = append(values, l-'a')
+		}
+	}
+	return values
+}
+
+func keyValues(key []byte) ([]byte, error) {
+	values := letterValues(key)
+	if len(key) == 0 || len(values) != len(key) {
+		return nil, ErrInvalidLetterKey
+	}
+	return values, nil
+}
+
+func caesarEncrypt(text []byte, shift int) []byte {
+	return mapLetters(text, func(_, x int) int { return x + shift })
+}
+
+func caesarDecrypt(text []byte, shift int) []byte {
+	return caesarEncrypt(text, -shift)
+}
+
+// inverse is the multiplicative inverse of a modulo 26, if there is one.
+func (k affineKey) inverse() (int, error) {
+	a := mod26(k.a)
+	for x := 1; x < 26; x += 2 {
+		if a*x%26 == 1 {
+			return x, nil
+		}
+	}
+	return 0, ErrInvalidAffineKey
+}
+
+func affineEncrypt(text []byte, key affineKey) ([]byte, error) {
+	if _, err := key.inverse(); err != nil {
+		return nil, err
+	}
+	return mapLetters(text, func(_, x int) int { return key.a*x + key.b }), nil
+}
+
+func affineDecrypt(text []byte, key affineKey) ([]byte, error) {
+	inv, err := key.inverse()
+	if err != nil {
+		return nil, err
+	}
+	return mapLetters(text, func(_, x int) int { return inv * (x - key.b) }), nil
+}
+
+func vigenereEncrypt(text, key []byte) ([]byte, error) {
+	k, err := keyValues(key)
+	if err != nil {
+		return nil, err
+	}
+	return mapLetters(text, func(i, x int) int { return x + int(k[i%len(k)]) }), nil
+}
+
+func vigenereDecrypt(text, key []byte) ([]byte, error) {
+	k, err := keyValues(key)
+	if err != nil {
+		return nil, err
+	}
+	return mapLetters(text, func(i, x int) int { return x - int(k[i%len(k)]) }), nil
+}
+
+// beaufortEncrypt subtracts the plaintext from the key rather than adding the
+// key to it, which makes the cipher its own inverse.
+func beaufortEncrypt(text, key []byte) ([]byte, error) {
+	k, err := keyValues(key)
+	if err != nil {
+		return nil, err
+	}
+	return mapLetters(text, func(i, x int) int { return int(k[i%len(k)]) - x }), nil
+}
+
+func beaufortDecrypt(text, key []byte) ([]byte, error) {
+	return beaufortEncrypt(text, key)
+}
+
+// autokeyEncrypt is Vigenère with key followed by the plaintext itself as the
+// keystream.
+func autokeyEncrypt(text, key []byte) ([]byte, error) {
+	k, err := keyValues(key)
+	if err != nil {
+		return nil, err
+	}
+	keystream := append(k, letterValues(text)...)
+	return mapLetters(text, func(i, x int) int { return x + int(keystream[i]) }), nil
+}
+
+func autokeyDecrypt(text, key []byte) ([]byte, error) {
+	k, err := keyValues(key)
+	if err != nil {
+		return nil, err
+	}
+	keystream := append([]byte(nil), k...)
+	return mapLetters(text, func(i, x int) int {
+		p := mod26(x - int(keystream[i]))
+		keystream = append(keystream, byte(p))
+		return p
+	}), nil
+}
+
+// letterCountsScore compares letter counts to letterFreq the way calcScore
+// does, but relative to the number of letters rather than of bytes.
+func letterCountsScore(counts *[26]int) float32 {
+	n := 0
+	for _, c := range counts {
+		n += c
+	}
+	if n == 0 {
+		return 0
+	}
+	score := float32(0)
+	for i, f1 := range letterFreqs {
+		f2 := float32(counts[i]) / float32(n)
+		score += absFloat32(f2 - f1) // L1 norm
+	}
+	return score
+}
+
+func scoreLetters(text []byte) float32 {
+	var counts [26]int
+	for _, x := range letterValues(text) {
+		counts[x]++
+	}
+	return letterCountsScore(&counts)
+}
+
+type letterKeyScore struct {
+	key   byte // From 0 to 25
+	score float32
+}
+
+// rankColumnKeys scores every key letter for column, letters from 0 to 25
+// that all used the same key letter, decrypting each with decrypt.
+func rankColumnKeys(column []byte, decrypt func(x, k int) int) []letterKeyScore {
+	var counts [26]int
+	for _, x := range column {
+		counts[x]++
+	}
+	ranked := make([]letterKeyScore, 26)
+	for k := range ranked {
+		var plain [26]int
+		for x, c := range counts {
+			plain[mod26(decrypt(x, k))] += c
+		}
+		ranked[k] = letterKeyScore{byte(k), letterCountsScore(&plain)}
+	}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].score < ranked[j].score
+	})
+	return ranked
+}
+
+func sortClassicalCandidates(candidates []classicalCandidate) {
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score < candidates[j].score
+	})
+}
+
+// crackCaesar ranks all 26 shifts.
+func crackCaesar(text []byte) []classicalCandidate {
+	ranked := rankColumnKeys(letterValues(text), func(x, k int) int { return x - k })
+	candidates := make([]classicalCandidate, len(ranked))
+	for i, r := range ranked {
+		candidates[i] = classicalCandidate{[]byte{'a' + r.key}, r.score, caesarDecrypt(text, int(r.key))}
+	}
+	return candidates
+}
+
+// crackAffine ranks all 312 affine keys.
+func crackAffine(text []byte) []affineCandidate {
+	var candidates []affineCandidate
+	for a := 1; a < 26; a += 2 {
+		for b := 0; b < 26; b++ {
+			key := affineKey{a, b}
+			plaintext, err := affineDecrypt(text, key)
+			if err != nil {
+				continue
+			}
+			candidates = append(candidates, affineCandidate{key, scoreLetters(plaintext), plaintext})
+		}
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].score < candidates[j].score
+	})
+	return candidates
+}
+
+func crackVigenere(text []byte, maxKeySize int) []classicalCandidate {
+	return crackPeriodicKey(text, maxKeySize, func(x, k int) int { return x - k }, vigenereDecrypt)
+}
+
+func crackBeaufort(text []byte, maxKeySize int) []classicalCandidate {
+	return crackPeriodicKey(text, maxKeySize, func(x, k int) int { return k - x }, beaufortDecrypt)
+}
+
+// crackPeriodicKey cracks a cipher that uses a repeating key the same way
+// crackRepeatingKeyXor does. The key sizes up to maxKeySize whose columns of
+// letters have an index of coincidence closest to English are taken, each
+// column is cracked as a shift of the alphabet with decryptLetter, and the
+// distinct keys are ranked by how well the whole plaintext scores.
+func crackPeriodicKey(text []byte, maxKeySize int, decryptLetter func(x, k int) int, decrypt func(text, key []byte) ([]byte, error)) []classicalCandidate {
+	letters := letterValues(text)
+	if len(letters) == 0 {
+		return nil
+	}
+	type sizeScore struct {
+		keySize int
+		score   float64
+	}
+	var sizes []sizeScore
+	for keySize := 1; keySize <= maxKeySize && (keySize == 1 || 2*keySize <= len(letters)); keySize++ {
+		sizes = append(sizes, sizeScore{keySize, math.Abs(float64(columnIC(letters, keySize)) - englishLetterIC)})
+	}
+	sort.SliceStable(sizes, func(i, j int) bool {
+		return sizes[i].score < sizes[j].score
+	})
+	if len(sizes) > classicalKeySizes {
+		sizes = sizes[:classicalKeySizes]
+	}
+	var candidates []classicalCandidate
+	seen := make(map[string]bool)
+	for _, size := range sizes {
+		key := make([]byte, size.keySize)
+		for i, column := range transposeColumns(letters, size.keySize) {
+			key[i] = 'a' + rankColumnKeys(column, decryptLetter)[0].key
+		}
+		key = keyPeriod(key)
+		if seen[string(key)] {
+			continue
+		}
+		seen[string(key)] = true
+		plaintext, _ := decrypt(text, key)
+		candidates = append(candidates, classicalCandidate{key, scoreLetters(plaintext), plaintext})
+	}
+	sortClassicalCandidates(candidates)
+	return candidates
+}
+
+// crackAutokey tries every primer length up to maxKeySize. The plaintext
+// letter at i is the ciphertext letter there minus the plaintext letter a
+// primer length before, so each column of the transposition is a chain that
+// only depends on its own primer letter and can be cracked on its own.
+func crackAutokey(text []byte, maxKeySize int) []classicalCandidate {
+	letters := letterValues(text)
+	var candidates []classicalCandidate
+	for keySize := 1; keySize <= maxKeySize && keySize <= len(letters); keySize++ {
+		key := make([]byte, keySize)
+		for i, column := range transposeColumns(letters, keySize) {
+			best := float32(math.Inf(1))
+			for k := 0; k < 26; k++ {
+				var counts [26]int
+				prev := k
+				for _, x := range column {
+					prev = mod26(int(x) - prev)
+					counts[prev]++
+				}
+				if score := letterCountsScore(&counts); score < best {
+					best, key[i] = score, 'a'+byte(k)
+				}
+			}
+		}
+		plaintext, _ := autokeyDecrypt(text, key)
+		candidates = append(candidates, classicalCandidate{key, scoreLetters(plaintext), plaintext})
+	}
+	sortClassicalCandidates(candidates)
+	return candidates
+}
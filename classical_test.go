@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"testing"
+)
+
+func TestClassicalCiphers(t *testing.T) {
+	affine := func(key affineKey) func([]byte) ([]byte, error) {
+		return func(text []byte) ([]byte, error) { return affineEncrypt(text, key) }
+	}
+	affineDec := func(key affineKey) func([]byte) ([]byte, error) {
+		return func(text []byte) ([]byte, error) { return affineDecrypt(text, key) }
+	}
+	keyed := func(fn func(text, key []byte) ([]byte, error), key string) func([]byte) ([]byte, error) {
+		return func(text []byte) ([]byte, error) { return fn(text, []byte(key)) }
+	}
+	caesar := func(shift int) func([]byte) ([]byte, error) {
+		return func(text []byte) ([]byte, error) { return caesarEncrypt(text, shift), nil }
+	}
+	caesarDec := func(shift int) func([]byte) ([]byte, error) {
+		return func(text []byte) ([]byte, error) { return caesarDecrypt(text, shift), nil }
+	}
+	tests := []struct {
+		name             string
+		encrypt, decrypt func([]byte) ([]byte, error)
+		plaintext, want  string
+	}{
+		{"rot13", caesar(13), caesarDec(13), "Hello, World!", "Uryyb, Jbeyq!"},
+		{"caesar", caesar(-23), caesarDec(-23), "attack at dawn", "dwwdfn dw gdzq"},
+		{"affine", affine(affineKey{5, 8}), affineDec(affineKey{5, 8}), "AFFINE cipher", "IHHWVC swfrcp"},
+		{"vigenere", keyed(vigenereEncrypt, "LEMON"), keyed(vigenereDecrypt, "lemon"), "Attack at dawn", "Lxfopv ef rnhr"},
+		{"beaufort", keyed(beaufortEncrypt, "FORTIFICATION"), keyed(beaufortDecrypt, "FORTIFICATION"), "DEFENDTHEEASTWALLOFTHECASTLE", "CKMPVCPVWPIWUJOGIUAPVWRIWUUK"},
+		{"autokey", keyed(autokeyEncrypt, "QUEENLY"), keyed(autokeyDecrypt, "QUEENLY"), "attack at dawn", "qnxepv yt wtwp"},
+	}
+	for _, tt := range tests {
+		got, err := tt.encrypt([]byte(tt.plaintext))
+		if err != nil || string(got) != tt.want {
+			t.Errorf("%s: encrypt got %q, '%v', want %q", tt.name, got, err, tt.want)
+		}
+		got, err = tt.decrypt([]byte(tt.want))
+		if err != nil || string(got) != tt.plaintext {
+			t.Errorf("%s: decrypt got %q, '%v', want %q", tt.name, got, err, tt.plaintext)
+		}
+	}
+}
+
+func TestClassicalInvalidKeys(t *testing.T) {
+	if _, err := affineEncrypt([]byte("text"), affineKey{13, 1}); err != ErrInvalidAffineKey {
+		t.Errorf("got '%v', want '%v'", err, ErrInvalidAffineKey)
+	}
+	if _, err := affineDecrypt([]byte("text"), affineKey{4, 1}); err != ErrInvalidAffineKey {
+		t.Errorf("got '%v', want '%v'", err, ErrInvalidAffineKey)
+	}
+	for _, key := range []string{"", "key1", "two words"} {
+		if _, err := vigenereEncrypt([]byte("text"), []byte(key)); err != ErrInvalidLetterKey {
+			t.Errorf("key %q: got '%v', want '%v'", key, err, ErrInvalidLetterKey)
+		}
+	}
+}
+
+func TestCrackClassical(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := corpus[:1000]
+
+	caesar := crackCaesar(caesarEncrypt(plaintext, 7))
+	if len(caesar) != 26 || string(caesar[0].key) != "h" || !bytes.Equal(caesar[0].plaintext, plaintext) {
+		t.Errorf("caesar: got %d keys, best %q", len(caesar), caesar[0].key)
+	}
+
+	input, _ := affineEncrypt(plaintext, affineKey{7, 3})
+	affine := crackAffine(input)
+	if len(affine) != 312 || affine[0].key != (affineKey{7, 3}) || !bytes.Equal(affine[0].plaintext, plaintext) {
+		t.Errorf("affine: got %d keys, best %v", len(affine), affine[0].key)
+	}
+
+	crackers := []struct {
+		name    string
+		encrypt func(text, key []byte) ([]byte, error)
+		crack   func(text []byte, maxKeySize int) []classicalCandidate
+	}{
+		{"vigenere", vigenereEncrypt, crackVigenere},
+		{"beaufort", beaufortEncrypt, crackBeaufort},
+		{"autokey", autokeyEncrypt, crackAutokey},
+	}
+	for _, c := range crackers {
+		for _, key := range []string{"lemon", "cryptopals"} {
+			input, _ := c.encrypt(plaintext, []byte(key))
+			ranked := c.crack(input, 20)
+			if len(ranked) == 0 {
+				t.Errorf("%s: no keys for %q", c.name, key)
+				continue
+			}
+			if string(ranked[0].key) != key || !bytes.Equal(ranked[0].plaintext, plaintext) {
+				t.Errorf("%s: got %q, want %q", c.name, ranked[0].key, key)
+			}
+			for i := 1; i < len(ranked); i++ {
+				if ranked[i].score < ranked[i-1].score {
+					t.Errorf("%s: keys out of order", c.name)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,244 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type cribMatch struct {
+	offset   int
+	revealed []byte // Text of the other message at offset
+	score    float32
+}
+
+// dragCrib slides crib along x, the XOR of two messages encrypted with the
+// same keystream. Wherever one message holds crib the other one is revealed,
+// so the offsets are ranked by how well the revealed text scores.
+func dragCrib(x, crib []byte, s scorer) []cribMatch {
+	if len(crib) == 0 || len(crib) > len(x) {
+		return nil
+	}
+	matches := make([]cribMatch, 0, len(x)-len(crib)+1)
+	for i := 0; i+len(crib) <= len(x); i++ {
+		revealed, _ := xor(x[i:i+len(crib)], crib)
+		matches = append(matches, cribMatch{i, revealed, s.score(revealed)})
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score < matches[j].score
+	})
+	return matches
+}
+
+// xorMessages is the XOR of two ciphertexts over the length of the shorter.
+func xorMessages(x, y []byte) []byte {
+	n := min(len(x), len(y))
+	xored, _ := xor(x[:n], y[:n])
+	return xored
+}
+
+// cribSession is the state of crib dragging over a set of ciphertexts that
+// share a keystream, which grows as cribs are accepted.
+type cribSession struct {
+	ciphertexts [][]byte
+	keystream   []byte
+	known       []bool
+}
+
+func newCribSession(ciphertexts [][]byte) *cribSession {
+	longest := 0
+	for _, c := range ciphertexts {
+		longest = max(longest, len(c))
+	}
+	return &cribSession{ciphertexts, make([]byte, longest), make([]bool, longest)}
+}
+
+// accept takes crib as the plaintext of message at offset, which fills in the
+// keystream there. Cribs that disagree with what is already known are
+// accepted all the same, as that is how mistakes get corrected.
+func (cs *cribSession) accept(message, offset int, crib []byte) error {
+	fixes := plaintextFixes(message, offset, string(crib))
+	if err := applyPlaintextFixes(cs.keystream, cs.ciphertexts, fixes); err != nil {
+		return err
+	}
+	for i := range crib {
+		cs.known[offset+i] = true
+	}
+	return nil
+}
+
+// forget marks n bytes of keystream from offset as unknown again.
+func (cs *cribSession) forget(offset, n int) {
+	for i := offset; i < offset+n && i < len(cs.known); i++ {
+		if i >= 0 {
+			cs.known[i] = false
+		}
+	}
+}
+
+// render writes every message with the bytes whose keystream is unknown shown
+// as '_' and other unprintable bytes as '.'.
+func (cs *cribSession) render(w io.Writer) {
+	plaintexts := decryptManyTimePad(cs.keystream, cs.ciphertexts)
+	for i, p := range plaintexts {
+		buf := []byte(asciiGutter(p))
+		for j := range buf {
+			if !cs.known[j] {
+				buf[j] = '_'
+			}
+		}
+		fmt.Fprintf(w, "%3d  %s\n", i, buf)
+	}
+}
+
+const cribDragHelp = `Commands:
+  drag I J CRIB      slide CRIB over messages I and J
+  accept I OFF CRIB  take CRIB as the text of message I at offset OFF
+  forget OFF N       drop N bytes of keystream from offset OFF
+  show               print all messages
+  keystream          print the keystream as hex, unknown bytes as ??
+  help               print this
+  quit               exit
+CRIB runs to the end of the line. Quote it to keep trailing spaces or use
+Go escapes, as in "the ".
+`
+
+// runCribDrag is the cribdrag command. Each file in args holds a ciphertext,
+// in any format loadFile understands, and commands are read from in.
+func runCribDrag(args []string, in io.Reader, out io.Writer) error {
+	flags := flag.NewFlagSet("cribdrag", flag.ContinueOnError)
+	flags.SetOutput(out)
+	top := flags.Int("n", 10, "number of offsets to show for each crib")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	if *top < 1 {
+		return errors.New("cribdrag: -n must be at least 1")
+	}
+	if flags.NArg() < 2 {
+		return errors.New("cribdrag: need at least two ciphertext files")
+	}
+	var ciphertexts [][]byte
+	for _, filename := range flags.Args() {
+		c, _, err := loadFile(filename)
+		if err != nil {
+			return err
+		}
+		ciphertexts = append(ciphertexts, c)
+	}
+	cs := newCribSession(ciphertexts)
+	cs.render(out)
+	scanner := bufio.NewScanner(in)
+	for fmt.Fprint(out, "> "); scanner.Scan(); fmt.Fprint(out, "> ") {
+		if err := cs.command(scanner.Text(), *top, out); err == io.EOF {
+			return nil
+		} else if err != nil {
+			fmt.Fprintln(out, "error:", err)
+		}
+	}
+	fmt.Fprintln(out)
+	return scanner.Err()
+}
+
+// command runs one line of input, returning io.EOF on quit.
+func (cs *cribSession) command(line string, top int, out io.Writer) error {
+	name, rest := splitWord(strings.TrimLeft(line, " \t"))
+	switch name {
+	case "":
+		return nil
+	case "drag":
+		nums, crib, err := parseCribArgs(rest, 2)
+		if err != nil {
+			return err
+		}
+		i, j := nums[0], nums[1]
+		for _, m := range nums {
+			if m < 0 || m >= len(cs.ciphertexts) {
+				return fmt.Errorf("no message %d", m)
+			}
+		}
+		matches := dragCrib(xorMessages(cs.ciphertexts[i], cs.ciphertexts[j]), crib, englishBytes)
+		for _, m := range matches[:min(top, len(matches))] {
+			fmt.Fprintf(out, "%5d  %8.3f  %q\n", m.offset, m.score, m.revealed)
+		}
+	case "accept":
+		nums, crib, err := parseCribArgs(rest, 2)
+		if err != nil {
+			return err
+		}
+		if err := cs.accept(nums[0], nums[1], crib); err != nil {
+			return err
+		}
+		cs.render(out)
+	case "forget":
+		nums, _, err := parseCribArgs(rest, 2)
+		if err != nil {
+			return err
+		}
+		cs.forget(nums[0], nums[1])
+		cs.render(out)
+	case "show":
+		cs.render(out)
+	case "keystream":
+		for i, k := range cs.keystream {
+			if cs.known[i] {
+				fmt.Fprint(out, toHexString([]byte{k}))
+			} else {
+				fmt.Fprint(out, "??")
+			}
+		}
+		fmt.Fprintln(out)
+	case "help":
+		fmt.Fprint(out, cribDragHelp)
+	case "quit", "exit":
+		return io.EOF
+	default:
+		return fmt.Errorf("unknown command %q, try help", name)
+	}
+	return nil
+}
+
+// parseCribArgs reads n integers and then a crib from the rest of the line.
+func parseCribArgs(s string, n int) ([]int, []byte, error) {
+	nums := make([]int, n)
+	for i := range nums {
+		var word string
+		word, s = splitWord(strings.TrimLeft(s, " \t"))
+		x, err := strconv.Atoi(word)
+		if err != nil {
+			return nil, nil, fmt.Errorf("expected a number, got %q", word)
+		}
+		nums[i] = x
+	}
+	crib := s
+	if strings.HasPrefix(strings.TrimSpace(crib), `"`) {
+		unquoted, err := strconv.Unquote(strings.TrimSpace(crib))
+		if err != nil {
+			return nil, nil, fmt.Errorf("bad quoted crib %s", strings.TrimSpace(crib))
+		}
+		crib = unquoted
+	}
+	return nums, []byte(crib), nil
+}
+
+// splitWord splits s at the first space, dropping that one space so that the
+// rest keeps any others.
+func splitWord(s string) (string, string) {
+	i := strings.IndexAny(s, " \t")
+	if i == -1 {
+		return s, ""
+	}
+	return s[:i], s[i+1:]
+}
+
+func max(x, y int) int {
+	if x > y {
+		return x
+	}
+	return y
+}
@@ -0,0 +1,131 @@
+package main
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestDragCrib(t *testing.T) {
+	ciphertexts, _ := encryptManyTimePad([]string{
+		"Attack the castle at dawn with all the men",
+		"Meet me by the old mill when the bell rings",
+	}, 3)
+	x := xorMessages(ciphertexts[0], ciphertexts[1])
+	matches := dragCrib(x, []byte(" the "), englishBytes)
+	if len(matches) != len(x)-4 {
+		t.Fatalf("got %d matches, want %d", len(matches), len(x)-4)
+	}
+	// " the " is at offset 6 of the first message, where the second has
+	// "e by ".
+	found := false
+	for _, m := range matches[:5] {
+		if m.offset == 6 && string(m.revealed) == "e by " {
+			found = true
+		}
+	}
+	if !found {
+		t.Errorf("offset 6 not in the top 5: %v", matches[:5])
+	}
+	if dragCrib(x, nil, englishBytes) != nil || dragCrib(x[:3], []byte("long"), englishBytes) != nil {
+		t.Error("expected no matches")
+	}
+}
+
+func TestCribSession(t *testing.T) {
+	messages := []string{"the cat sat", "a dog ran off"}
+	ciphertexts, _ := encryptManyTimePad(messages, 4)
+	cs := newCribSession(ciphertexts)
+	if err := cs.accept(0, 4, []byte("cat")); err != nil {
+		t.Fatal(err)
+	}
+	var b strings.Builder
+	cs.render(&b)
+	want := "  0  ____cat____\n  1  ____g r______\n"
+	if b.String() != want {
+		t.Errorf("render = %q, want %q", b.String(), want)
+	}
+	if err := cs.accept(1, 10, []byte("offs")); err == nil {
+		t.Error("accepted a crib past the end of the message")
+	}
+	cs.forget(5, 100)
+	b.Reset()
+	cs.render(&b)
+	if want := "  0  ____c______\n  1  ____g________\n"; b.String() != want {
+		t.Errorf("render = %q, want %q", b.String(), want)
+	}
+}
+
+func TestRunCribDrag(t *testing.T) {
+	messages := []string{"Meet at the north gate", "Bring the maps and food"}
+	ciphertexts, _ := encryptManyTimePad(messages, 5)
+	dir, err := ioutil.TempDir("", "cribdrag")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	var files []string
+	for i, c := range ciphertexts {
+		name := filepath.Join(dir, string(rune('a'+i))+".hex")
+		if err := ioutil.WriteFile(name, []byte(toHexString(c)+"\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		files = append(files, name)
+	}
+	in := strings.NewReader(strings.Join([]string{
+		`drag 0 1 " the "`,
+		`accept 0 0 Meet at the north gate`,
+		`bogus`,
+		`keystream`,
+		`quit`,
+	}, "\n"))
+	var out bytes.Buffer
+	if err := runCribDrag(append([]string{"-n", "3"}, files...), in, &out); err != nil {
+		t.Fatal(err)
+	}
+	got := out.String()
+	for _, want := range []string{
+		"  0  ______________________\n",
+		"    7  ",
+		"  0  Meet at the north gate\n  1  Bring the maps and foo_\n",
+		`error: unknown command "bogus"`,
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output doesn't contain %q:\n%s", want, got)
+		}
+	}
+	if err := runCribDrag(files[:1], strings.NewReader(""), &out); err == nil {
+		t.Error("expected an error for a single file")
+	}
+	if err := runCribDrag(append([]string{"-n", "-1"}, files...), strings.NewReader("drag 0 1 the\n"), &out); err == nil {
+		t.Error("expected an error for -n -1")
+	}
+}
+
+func TestParseCribArgs(t *testing.T) {
+	for _, test := range []struct {
+		in   string
+		nums []int
+		crib string
+		ok   bool
+	}{
+		{"1 2 the", []int{1, 2}, "the", true},
+		{"1 2  the ", []int{1, 2}, " the ", true},
+		{`1 2 " the "`, []int{1, 2}, " the ", true},
+		{`1 2 "\x00a"`, []int{1, 2}, "\x00a", true},
+		{"1 x the", nil, "", false},
+		{`1 2 "open`, nil, "", false},
+	} {
+		nums, crib, err := parseCribArgs(test.in, 2)
+		if (err == nil) != test.ok {
+			t.Errorf("parseCribArgs(%q): '%v'", test.in, err)
+			continue
+		}
+		if test.ok && (nums[0] != test.nums[0] || nums[1] != test.nums[1] || string(crib) != test.crib) {
+			t.Errorf("parseCribArgs(%q) = %v, %q", test.in, nums, crib)
+		}
+	}
+}
@@ -0,0 +1,373 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+type hexdumpFormat int
+
+var ErrInvalidHexdumpOffset = errors.New("parseHexdump: offset after \"*\" out of range")
+
+// maxHexdumpRepeat is how many bytes a "*" line may expand to. Dumps of
+// anything larger aren't pasted, so an offset further ahead is a mistake.
+const maxHexdumpRepeat = 1 << 26
+
+const (
+	formatPlain     hexdumpFormat = iota // xxd -p
+	formatXxd                            // xxd
+	formatHexdumpC                       // hexdump -C
+	formatOd                             // od -A x -t x1
+	formatWireshark                      // Wireshark "Copy as Hex Dump"
+	formatCArray                         // xxd -i
+)
+
+// parseHexdump extracts the bytes from hex pasted in any of the formats above,
+// or just hex split over lines. Offsets, ASCII gutters, whitespace and 0x
+// prefixes are stripped and case is ignored. Lines collapsed to "*" by
+// hexdump and od are expanded again.
+func parseHexdump(s string) ([]byte, error) {
+	lower := strings.ToLower(s)
+	if isCArray(lower) {
+		return parseCArray(lower)
+	}
+	var out, prev []byte
+	dump := false   // Set once a line with an offset has been seen
+	repeat := false // Set after a "*" line
+	bases := allOffsetBases
+	for i, line := range strings.Split(lower, "\n") {
+		line = strings.TrimRight(line, "\r")
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case len(fields) == 1 && fields[0] == "*":
+			repeat = true
+			continue
+		}
+		skipped := 0
+		offset, ok := lineOffset(fields, len(out), dump, &bases)
+		if ok {
+			dump = true
+			if repeat && len(prev) > 0 {
+				if offset < 0 || offset-len(out) > maxHexdumpRepeat {
+					col := strings.Index(line, fields[0])
+					return nil, &decodeError{codec: "hexdump", offset: int64(col), char: line[col], line: i + 1, err: ErrInvalidHexdumpOffset}
+				}
+				for len(out) < offset {
+					out = append(out, prev...)
+				}
+				out = out[:offset]
+			}
+			repeat = false
+			if len(fields) == 1 {
+				continue
+			}
+			skipped = strings.Index(line, fields[0]) + len(fields[0])
+			line = line[skipped:]
+		}
+		bs, err := parseHexdumpLine(line)
+		if err != nil {
+			if derr, ok := err.(*decodeError); ok {
+				derr.codec = "hexdump"
+				derr.offset += int64(skipped)
+				derr.line = i + 1
+			}
+			return nil, err
+		}
+		out = append(out, bs...)
+		prev = bs
+	}
+	return out, nil
+}
+
+// offsetBases are the bases dumps show offsets in: hex, octal as od does by
+// default and decimal as od -A d does. A set of them is a bit mask indexed by
+// position.
+var offsetBases = [...]int{16, 8, 10}
+
+const allOffsetBases uint = 1<<len(offsetBases) - 1
+
+// lineOffset reports whether the first field is an offset, that is if it ends
+// with ':' or if its value in one of bases is the number of bytes read so far.
+// bases is narrowed down to those that fit, so that the offset after a "*"
+// line, which jumps ahead, is read in the same base as the ones before it. A
+// line with nothing but an offset only counts inside a dump.
+func lineOffset(fields []string, count int, dump bool, bases *uint) (int, bool) {
+	first := fields[0]
+	if strings.HasSuffix(first, ":") {
+		n, err := strconv.ParseUint(first[:len(first)-1], 16, 64)
+		return int(n), err == nil
+	}
+	if len(first) < 4 || (len(fields) == 1 && !dump) {
+		return 0, false
+	}
+	for _, f := range fields[1:] {
+		if _, err := fromHexString(hex(f)); err == nil && len(f) >= len(first) {
+			return 0, false
+		}
+	}
+	var offsets [len(offsetBases)]int
+	var valid, match uint
+	for i, base := range offsetBases {
+		if n, err := strconv.ParseUint(first, base, 64); err == nil {
+			offsets[i] = int(n)
+			valid |= 1 << i
+			if int(n) == count {
+				match |= 1 << i
+			}
+		}
+	}
+	if match&*bases != 0 {
+		*bases &= match
+		return count, true
+	}
+	if !dump {
+		return 0, false
+	}
+	for i := range offsetBases {
+		if mask := uint(1) << i; *bases&valid&mask != 0 && offsets[i] > count {
+			*bases = mask
+			return offsets[i], true
+		}
+	}
+	return 0, false
+}
+
+// parseHexdumpLine decodes the hex on a line with the offset removed. A
+// trailing ASCII gutter is recognised by it matching the bytes before it.
+func parseHexdumpLine(line string) ([]byte, error) {
+	type token struct {
+		start, end int
+		bytes      []byte
+	}
+	var tokens []token
+	var firstErr error
+	for i := 0; i < len(line); {
+		for i < len(line) && isSpace(line[i]) {
+			i++
+		}
+		j := i
+		for j < len(line) && !isSpace(line[j]) {
+			j++
+		}
+		if i == j {
+			break
+		}
+		f := strings.TrimPrefix(line[i:j], "0x")
+		bs, err := fromHexString(hex(f))
+		if err != nil {
+			if derr, ok := err.(*decodeError); ok {
+				derr.offset += int64(j - len(f))
+			}
+			firstErr = err
+			break
+		}
+		tokens = append(tokens, token{i, j, bs})
+		i = j
+	}
+	var bs []byte
+	for _, t := range tokens {
+		bs = append(bs, t.bytes...)
+	}
+	// Some of the gutter may look like hex, so try every split before taking
+	// all tokens. Every format puts at least two spaces before the gutter.
+	n := len(bs)
+	for k := len(tokens); k > 0; k-- {
+		rest := line[tokens[k-1].end:]
+		if k < len(tokens) {
+			n -= len(tokens[k].bytes)
+		}
+		if k == len(tokens) && firstErr == nil || !strings.HasPrefix(rest, "  ") {
+			continue
+		}
+		gutter := strings.TrimSpace(rest)
+		want := strings.ToLower(asciiGutter(bs[:n]))
+		if gutter == strings.TrimSpace(want) || gutter == "|"+want+"|" || gutter == ">"+want+"<" {
+			return bs[:n], nil
+		}
+	}
+	return bs, firstErr
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\r'
+}
+
+// isCArray looks for the start of an array initializer or a line of 0x
+// literals. Lines starting with an offset are skipped so that their gutters
+// are never mistaken for code.
+func isCArray(s string) bool {
+	for _, line := range strings.Split(s, "\n") {
+		fields := strings.Fields(line)
+		if len(fields) == 0 {
+			continue
+		}
+		if _, err := strconv.ParseUint(strings.TrimSuffix(fields[0], ":"), 16, 64); err == nil {
+			continue
+		}
+		line = strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(line, "{"),
+			strings.HasSuffix(line, "{") && strings.Contains(line, "="),
+			strings.Contains(line, "{0x") && strings.Contains(line, "="),
+			strings.HasPrefix(line, "0x") && strings.Contains(line, ","):
+			return true
+		}
+	}
+	return false
+}
+
+// parseCArray collects every 0x literal in s, ignoring the rest.
+func parseCArray(s string) ([]byte, error) {
+	var out []byte
+	for i := strings.Index(s, "0x"); i != -1; {
+		j := i + 2
+		for j < len(s) && byteFromHex(s[j]) <= 15 {
+			j++
+		}
+		digits := s[i+2 : j]
+		switch len(digits) {
+		case 0:
+			if j == len(s) {
+				return nil, lenError("hexdump", ErrInvalidHexLen, s)
+			}
+			return nil, charError("hexdump", ErrInvalidHexChar, s, j)
+		case 1, 2:
+			n, _ := strconv.ParseUint(digits, 16, 8)
+			out = append(out, byte(n))
+		default:
+			return nil, charError("hexdump", ErrInvalidHexChar, s, i+4)
+		}
+		k := strings.Index(s[j:], "0x")
+		if k == -1 {
+			break
+		}
+		i = j + k
+	}
+	return out, nil
+}
+
+// asciiGutter renders bytes the way xxd and hexdump do next to the hex.
+func asciiGutter(bs []byte) string {
+	buf := make([]byte, len(bs))
+	for i, b := range bs {
+		if b >= 0x20 && b < 0x7f {
+			buf[i] = b
+		} else {
+			buf[i] = '.'
+		}
+	}
+	return string(buf)
+}
+
+// renderHexdump writes bs the way the tool behind format would.
+func renderHexdump(bs []byte, format hexdumpFormat) string {
+	var b strings.Builder
+	switch format {
+	case formatPlain:
+		for i := 0; i < len(bs); i += 30 {
+			b.WriteString(string(toHexString(bs[i:min(i+30, len(bs))])))
+			b.WriteByte('\n')
+		}
+	case formatXxd:
+		for i := 0; i < len(bs); i += 16 {
+			line := bs[i:min(i+16, len(bs))]
+			fmt.Fprintf(&b, "%08x: ", i)
+			for j := 0; j < 16; j++ {
+				if j < len(line) {
+					b.WriteString(string(toHexString(line[j : j+1])))
+				} else {
+					b.WriteString("  ")
+				}
+				if j%2 == 1 {
+					b.WriteByte(' ')
+				}
+			}
+			fmt.Fprintf(&b, " %s\n", asciiGutter(line))
+		}
+	case formatHexdumpC, formatOd:
+		var prev []byte
+		starred := false
+		for i := 0; i < len(bs); i += 16 {
+			line := bs[i:min(i+16, len(bs))]
+			if len(line) == 16 && bytes.Equal(line, prev) {
+				if !starred {
+					b.WriteString("*\n")
+					starred = true
+				}
+				continue
+			}
+			prev, starred = line, false
+			if format == formatOd {
+				fmt.Fprintf(&b, "%06x", i)
+				for _, x := range line {
+					fmt.Fprintf(&b, " %s", toHexString([]byte{x}))
+				}
+				b.WriteByte('\n')
+				continue
+			}
+			fmt.Fprintf(&b, "%08x  ", i)
+			for j := 0; j < 16; j++ {
+				if j < len(line) {
+					fmt.Fprintf(&b, "%s ", toHexString(line[j:j+1]))
+				} else {
+					b.WriteString("   ")
+				}
+				if j == 7 {
+					b.WriteByte(' ')
+				}
+			}
+			fmt.Fprintf(&b, " |%s|\n", asciiGutter(line))
+		}
+		if len(bs) > 0 {
+			if format == formatOd {
+				fmt.Fprintf(&b, "%06x\n", len(bs))
+			} else {
+				fmt.Fprintf(&b, "%08x\n", len(bs))
+			}
+		}
+	case formatWireshark:
+		for i := 0; i < len(bs); i += 16 {
+			line := bs[i:min(i+16, len(bs))]
+			fmt.Fprintf(&b, "%04x  ", i)
+			for j := 0; j < 16; j++ {
+				if j < len(line) {
+					fmt.Fprintf(&b, " %s", toHexString(line[j:j+1]))
+				} else {
+					b.WriteString("   ")
+				}
+			}
+			fmt.Fprintf(&b, "   %s\n", asciiGutter(line))
+		}
+	case formatCArray:
+		b.WriteString("{\n")
+		for i := 0; i < len(bs); i += 12 {
+			b.WriteString(" ")
+			for j, x := range bs[i:min(i+12, len(bs))] {
+				if j > 0 {
+					b.WriteByte(',')
+				}
+				fmt.Fprintf(&b, " 0x%s", toHexString([]byte{x}))
+			}
+			if i+12 < len(bs) {
+				b.WriteByte(',')
+			}
+			b.WriteByte('\n')
+		}
+		b.WriteString("}\n")
+	default:
+		panic("renderHexdump: unknown format")
+	}
+	return b.String()
+}
+
+func min(x, y int) int {
+	if x < y {
+		return x
+	}
+	return y
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestParseHexdump(t *testing.T) {
+	want := "Man is distinguished\n"
+	tests := []string{
+		// xxd
+		`00000000: 4d61 6e20 6973 2064 6973 7469 6e67 7569  Man is distingui
+00000010: 7368 6564 0a                             shed.`,
+		// hexdump -C
+		`00000000  4d 61 6e 20 69 73 20 64  69 73 74 69 6e 67 75 69  |Man is distingui|
+00000010  73 68 65 64 0a                                    |shed.|
+00000015`,
+		// od -A x -t x1z
+		`000000 4d 61 6e 20 69 73 20 64 69 73 74 69 6e 67 75 69  >Man is distingui<
+000010 73 68 65 64 0a                                   >shed.<
+000015`,
+		// od -t x1
+		`0000000 4d 61 6e 20 69 73 20 64 69 73 74 69 6e 67 75 69
+0000020 73 68 65 64 0a
+0000025`,
+		// Wireshark
+		`0000   4d 61 6e 20 69 73 20 64 69 73 74 69 6e 67 75 69   Man is distingui
+0010   73 68 65 64 0a                                    shed.`,
+		// xxd -i
+		`unsigned char man[] = {
+  0x4d, 0x61, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x64, 0x69, 0x73, 0x74, 0x69,
+  0x6e, 0x67, 0x75, 0x69, 0x73, 0x68, 0x65, 0x64, 0x0A
+};
+unsigned int man_len = 21;`,
+		// Mixed case, prefixes and whitespace
+		"0x4D 0x61 0x6e 20 69 73 20 64\r\n\t69737469 6E67756973686564 0a\n",
+	}
+	for _, input := range tests {
+		got, err := parseHexdump(input)
+		if err != nil || string(got) != want {
+			t.Errorf("parseHexdump(%q) = %q, '%v', want %q", input, got, err, want)
+		}
+	}
+
+	// od shows offsets in octal by default, or in decimal with -A d, and the
+	// offset after a "*" line must be read in the same base.
+	collapsed := "Header!!" + strings.Repeat("\x00", 160) + "tail of file"
+	for _, input := range []string{
+		// od -t x1
+		`0000000 48 65 61 64 65 72 21 21 00 00 00 00 00 00 00 00
+0000020 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00
+*
+0000240 00 00 00 00 00 00 00 00 74 61 69 6c 20 6f 66 20
+0000260 66 69 6c 65
+0000264`,
+		// od -A d -t x1
+		`0000000 48 65 61 64 65 72 21 21 00 00 00 00 00 00 00 00
+0000016 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00 00
+*
+0000160 00 00 00 00 00 00 00 00 74 61 69 6c 20 6f 66 20
+0000176 66 69 6c 65
+0000180`,
+	} {
+		got, err := parseHexdump(input)
+		if err != nil || string(got) != collapsed {
+			t.Errorf("parseHexdump(%q) = %q, '%v', want %q", input, got, err, collapsed)
+		}
+	}
+
+	// The gutter is valid hex but must not be taken as data.
+	got, err := parseHexdump("00000000: 6162 6364                                abcd\n")
+	if err != nil || string(got) != "abcd" {
+		t.Errorf("parseHexdump = %q, '%v', want \"abcd\"", got, err)
+	}
+}
+
+func TestParseHexdumpError(t *testing.T) {
+	_, err := parseHexdump("00000000: 4d61 6e20\n00000004: 6973 2g64\n")
+	var derr *decodeError
+	if !errors.Is(err, ErrInvalidHexChar) || !errors.As(err, &derr) ||
+		derr.line != 2 || derr.offset != 16 || derr.char != 'g' {
+		t.Errorf("parseHexdump = '%v', want '%v' at offset 16 on line 2", err, ErrInvalidHexChar)
+	}
+}
+
+func TestParseHexdumpRepeatTooFar(t *testing.T) {
+	_, err := parseHexdump("00000000: 4142 4344 4546 4748 494a 4b4c 4d4e 4f50  ABCDEFGHIJKLMNOP\n*\n7fffffffff: 41\n")
+	var derr *decodeError
+	if !errors.Is(err, ErrInvalidHexdumpOffset) || !errors.As(err, &derr) || derr.line != 3 {
+		t.Errorf("parseHexdump = '%v', want '%v' on line 3", err, ErrInvalidHexdumpOffset)
+	}
+}
+
+func TestParseCArrayTrailingPrefix(t *testing.T) {
+	_, err := parseHexdump("{~;9=,aCZ*M=0x")
+	var derr *decodeError
+	if !errors.Is(err, ErrInvalidHexLen) || !errors.As(err, &derr) || !derr.length {
+		t.Errorf("parseHexdump = '%v', want '%v'", err, ErrInvalidHexLen)
+	}
+}
+
+func TestRenderHexdumpInverse(t *testing.T) {
+	formats := []hexdumpFormat{formatPlain, formatXxd, formatHexdumpC, formatOd, formatWireshark, formatCArray}
+	for i := 0; i < 200; i++ {
+		bs := make([]byte, rand.Intn(200))
+		rand.Read(bs)
+		if len(bs) > 64 && rand.Intn(2) == 0 {
+			// Identical lines are collapsed by hexdump and od.
+			copy(bs[16:32], bs[:16])
+			copy(bs[32:48], bs[:16])
+		}
+		for _, format := range formats {
+			s := renderHexdump(bs, format)
+			got, err := parseHexdump(s)
+			if err != nil || !bytes.Equal(got, bs) {
+				t.Fatalf("parseHexdump(%q) = '%v', '%v', want '%v'", s, got, err, bs)
+			}
+		}
+	}
+}
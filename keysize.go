@@ -0,0 +1,234 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sort"
+)
+
+type keySizeEstimator int
+
+const (
+	estimateHamming  keySizeEstimator = iota // Hamming distance between neighbouring blocks
+	estimateIC                               // Index of coincidence within each column
+	estimateKasiski                          // Distances between repeated trigrams
+	estimateFriedman                         // Index of coincidence of the whole input
+)
+
+// xorOptions tune the repeating-key XOR crackers. The zero value gives the
+// defaults.
+type xorOptions struct {
+	maxKeySize int // Largest key size tried, 40 if 0
+	candidates int // Number of key sizes to crack keys for, 3 if 0
+	estimator  keySizeEstimator
+	workers    int // Goroutines to spread the work over, one per CPU if 0
+
+	// Bytes that the key and the plaintext may hold, or nil for any.
+	keyBytes, plaintextBytes *byteSet
+
+	beamWidth  int // Partial keys kept by beam search, 32 if 0
+	columnKeys int // Bytes tried for each key position by beam search, 5 if 0
+}
+
+const minKeySize = 2
+
+var (
+	ErrInputTooShort = errors.New("estimateKeySizes: input too short")
+	ErrNoRepeats     = errors.New("estimateKeySizes: no repeated trigrams")
+)
+
+func (e keySizeEstimator) String() string {
+	switch e {
+	case estimateHamming:
+		return "Hamming"
+	case estimateIC:
+		return "IC"
+	case estimateKasiski:
+		return "Kasiski"
+	case estimateFriedman:
+		return "Friedman"
+	default:
+		return "unknown"
+	}
+}
+
+func (o xorOptions) withDefaults() xorOptions {
+	if o.maxKeySize <= 0 {
+		o.maxKeySize = 40
+	}
+	if o.candidates <= 0 {
+		o.candidates = 3
+	}
+	if o.beamWidth <= 0 {
+		o.beamWidth = 32
+	}
+	if o.columnKeys <= 0 {
+		o.columnKeys = 5
+	}
+	return o
+}
+
+type keySizeScore struct {
+	keySize int
+	score   float32 // From 0 to 1, lower is better
+}
+
+// estimateKeySizes scores every key size from minKeySize up to the maximum
+// with the chosen estimator and returns them best first. Key sizes that don't
+// fit twice in the input are skipped, and if none fit ErrInputTooShort is
+// returned. Key sizes are scored in parallel.
+func estimateKeySizes(ctx context.Context, input []byte, opts xorOptions) ([]keySizeScore, error) {
+	opts = opts.withDefaults()
+	maxKeySize := opts.maxKeySize
+	if maxKeySize > len(input)/2 {
+		maxKeySize = len(input) / 2
+	}
+	if maxKeySize < minKeySize {
+		return nil, ErrInputTooShort
+	}
+	var score func(keySize int) float32
+	switch opts.estimator {
+	case estimateHamming:
+		score = func(keySize int) float32 { return hammingKeySizeScore(input, keySize) }
+	case estimateIC:
+		score = func(keySize int) float32 { return 1 - columnIC(input, keySize) }
+	case estimateKasiski:
+		distances := repeatDistances(input)
+		if len(distances) == 0 {
+			return nil, ErrNoRepeats
+		}
+		score = func(keySize int) float32 { return kasiskiKeySizeScore(distances, keySize) }
+	case estimateFriedman:
+		est := friedmanKeySize(input)
+		score = func(keySize int) float32 {
+			if math.IsInf(est, 1) {
+				return 1
+			}
+			k := float64(keySize)
+			return float32(math.Abs(k-est) / math.Max(k, est))
+		}
+	default:
+		panic("estimateKeySizes: unknown estimator")
+	}
+	scores := make([]keySizeScore, maxKeySize-minKeySize+1)
+	err := parallelFor(ctx, len(scores), opts.workers, func(i int) {
+		keySize := minKeySize + i
+		scores[i] = keySizeScore{keySize, score(keySize)}
+	})
+	if err != nil {
+		return nil, err
+	}
+	// Every divisor of the key size does as well as the key size itself with
+	// Kasiski, so ties go to the larger size there and to the smaller one for
+	// the rest.
+	if opts.estimator == estimateKasiski {
+		for i, j := 0, len(scores)-1; i < j; i, j = i+1, j-1 {
+			scores[i], scores[j] = scores[j], scores[i]
+		}
+	}
+	sort.SliceStable(scores, func(i, j int) bool {
+		return scores[i].score < scores[j].score
+	})
+	return scores, nil
+}
+
+// hammingKeySizeScore is the share of bits that differ between each block of
+// keySize bytes and the next, over the whole input.
+func hammingKeySizeScore(input []byte, keySize int) float32 {
+	var dist, pairs int
+	for i := 0; i+2*keySize <= len(input); i += keySize {
+		d, _ := hammingDistance(input[i:i+keySize], input[i+keySize:i+2*keySize])
+		dist += d
+		pairs++
+	}
+	return float32(dist) / float32(8*keySize*pairs)
+}
+
+// columnIC is the mean over the columns of bytes encrypted with the same key
+// byte of the chance that two bytes from the column are equal.
+func columnIC(input []byte, keySize int) float32 {
+	var sum float64
+	for col := 0; col < keySize; col++ {
+		var counts [256]int
+		n := 0
+		for i := col; i < len(input); i += keySize {
+			counts[input[i]]++
+			n++
+		}
+		sum += coincidence(counts[:], n)
+	}
+	return float32(sum / float64(keySize))
+}
+
+func coincidence(counts []int, n int) float64 {
+	if n < 2 {
+		return 0
+	}
+	var same int
+	for _, c := range counts {
+		same += c * (c - 1)
+	}
+	return float64(same) / float64(n*(n-1))
+}
+
+// repeatDistances returns the distance from every trigram to where it last
+// occurred before.
+func repeatDistances(input []byte) []int {
+	var distances []int
+	last := make(map[[3]byte]int)
+	for i := 0; i+3 <= len(input); i++ {
+		t := [3]byte{input[i], input[i+1], input[i+2]}
+		if j, ok := last[t]; ok {
+			distances = append(distances, i-j)
+		}
+		last[t] = i
+	}
+	return distances
+}
+
+// kasiskiKeySizeScore is how much fewer than all of the distances are
+// multiples of keySize, relative to how many would be by chance.
+func kasiskiKeySizeScore(distances []int, keySize int) float32 {
+	n := 0
+	for _, d := range distances {
+		if d%keySize == 0 {
+			n++
+		}
+	}
+	chance := 1 / float32(keySize)
+	share := float32(n) / float32(len(distances))
+	score := 1 - (share-chance)/(1-chance)
+	if score > 1 {
+		score = 1
+	}
+	return score
+}
+
+// englishIC is the chance that two bytes of English text are equal, from
+// englishByteCounts.
+var englishIC = func() float64 {
+	var counts [256]int
+	n := 0
+	for c, x := range englishByteCounts {
+		counts[c] = int(x)
+		n += int(x)
+	}
+	return coincidence(counts[:], n)
+}()
+
+// friedmanKeySize estimates the key size from how much the index of
+// coincidence of the input has moved from that of English towards that of
+// random bytes.
+func friedmanKeySize(input []byte) float64 {
+	var counts [256]int
+	for _, c := range input {
+		counts[c]++
+	}
+	const random = 1.0 / 256
+	ic := coincidence(counts[:], len(input))
+	if ic <= random {
+		return math.Inf(1)
+	}
+	return (englishIC - random) / (ic - random)
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+)
+
+func TestEstimateKeySizes(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rng := rand.New(rand.NewSource(1))
+	for _, keySize := range []int{3, 7, 16, 29, 40} {
+		key := make([]byte, keySize)
+		rng.Read(key)
+		input, _ := repeatingKeyXor(corpus[:3000], key)
+		for _, e := range []keySizeEstimator{estimateHamming, estimateIC, estimateKasiski} {
+			scores, err := estimateKeySizes(context.Background(), input, xorOptions{estimator: e})
+			if err != nil {
+				t.Fatalf("%v: %v", e, err)
+			}
+			// Multiples of the key size fit as well as the key size itself.
+			if scores[0].keySize%keySize != 0 {
+				t.Errorf("%v: key size %d ranked first, want a multiple of %d", e, scores[0].keySize, keySize)
+			}
+			if len(scores) != 40-minKeySize+1 {
+				t.Errorf("%v: got %d key sizes, want %d", e, len(scores), 40-minKeySize+1)
+			}
+			for _, s := range scores {
+				if s.score < 0 || s.score > 1 {
+					t.Errorf("%v: key size %d scored %v", e, s.keySize, s.score)
+				}
+			}
+		}
+	}
+}
+
+func TestEstimateKeySizesFriedman(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	input, _ := repeatingKeyXor(corpus, []byte{0x5a})
+	scores, err := estimateKeySizes(context.Background(), input, xorOptions{estimator: estimateFriedman})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A single-byte key leaves the index of coincidence as it was, so the
+	// smallest size tried is the best fit.
+	if scores[0].keySize != minKeySize {
+		t.Errorf("key size %d ranked first, want %d", scores[0].keySize, minKeySize)
+	}
+	noise := make([]byte, 1000)
+	for i := range noise {
+		noise[i] = byte(i)
+	}
+	scores, err = estimateKeySizes(context.Background(), noise, xorOptions{estimator: estimateFriedman})
+	if err != nil || scores[0].score != 1 {
+		t.Errorf("estimateKeySizes(context.Background(), noise) = %v, '%v'", scores[:1], err)
+	}
+}
+
+func TestEstimateKeySizesShortInput(t *testing.T) {
+	input := []byte("0123456789")
+	scores, err := estimateKeySizes(context.Background(), input, xorOptions{maxKeySize: 100})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(scores) != 4 {
+		t.Errorf("got %d key sizes, want 4", len(scores))
+	}
+	for _, s := range scores {
+		if s.keySize > 5 {
+			t.Errorf("key size %d doesn't fit twice", s.keySize)
+		}
+	}
+	if _, err := estimateKeySizes(context.Background(), input[:3], xorOptions{}); !errors.Is(err, ErrInputTooShort) {
+		t.Errorf("got '%v', want '%v'", err, ErrInputTooShort)
+	}
+	if _, err := findRepeatingKeyXorCandidates(context.Background(), input[:3], l1Scorer{}, xorOptions{}); !errors.Is(err, ErrInputTooShort) {
+		t.Errorf("got '%v', want '%v'", err, ErrInputTooShort)
+	}
+	if _, err := estimateKeySizes(context.Background(), input, xorOptions{estimator: estimateKasiski}); !errors.Is(err, ErrNoRepeats) {
+		t.Errorf("got '%v', want '%v'", err, ErrNoRepeats)
+	}
+}
+
+func TestFindRepeatingKeyXorCandidatesOptions(t *testing.T) {
+	input, _, err := loadFile("data/6.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	candidates, err := findRepeatingKeyXorCandidates(context.Background(), input, l1Scorer{}, xorOptions{maxKeySize: 20, candidates: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candidates) != 5 {
+		t.Fatalf("got %d candidates, want 5", len(candidates))
+	}
+	for i, c := range candidates {
+		if c.keySize > 20 || len(c.key) != c.keySize {
+			t.Errorf("candidate %d: key size %d, key %q", i, c.keySize, c.key)
+		}
+		if i > 0 && c.sizeScore < candidates[i-1].sizeScore {
+			t.Errorf("candidate %d scores better than %d", i, i-1)
+		}
+	}
+}
@@ -0,0 +1,307 @@
+package main
+
+import (
+	"errors"
+	"sort"
+)
+
+// anyOffset marks a known plaintext fragment whose offset isn't known.
+const anyOffset = -1
+
+type knownPlaintext struct {
+	offset int // Offset in the plaintext, or anyOffset
+	text   []byte
+}
+
+// partialKey is a repeating XOR key of which only some bytes are known.
+type partialKey struct {
+	key     []byte
+	known   []bool // Which bytes of key the fragments determine
+	offsets []int  // Where each fragment was placed, or anyOffset if it wasn't
+	checked int    // Fragment bytes that fell on key bytes already known and agreed
+}
+
+var ErrInvalidFragment = errors.New("recoverRepeatingKeyXor: fragment outside of ciphertext")
+
+// maxPartialKeys caps how many keys of each size that fragments have been
+// checked against are kept while placing them, how many of each size that
+// they haven't are returned, and how many keys are returned in all. The ones
+// with the most checked bytes are kept.
+const maxPartialKeys = 1024
+
+// recoverRepeatingKeyXor finds the repeating XOR keys of up to maxKeySize
+// bytes under which the fragments could be plaintext of ciphertext. For each
+// key size, every fragment gives key bytes wherever it is placed, and some of
+// those bytes may be checked, by a fragment wrapping around the key or by two
+// fragments landing on the same key bytes. Keys without checked bytes are
+// returned too, after the others, since a header shorter than the key still
+// gives part of it for completeKey to fill in.
+//
+// Fragments at fixed offsets are placed first, then the ones at anyOffset,
+// longest first. A fragment at anyOffset is only placed where it is checked,
+// except the first one if nothing is known yet, and is left unplaced if it
+// can't be. Where that first one isn't checked, only its first
+// maxPartialKeys such placements are kept. Keys are ordered by how many bytes were checked, then by size and
+// by how many bytes are known, and keys that repeat a shorter key found too
+// are left out.
+func recoverRepeatingKeyXor(ciphertext []byte, fragments []knownPlaintext, maxKeySize int) ([]partialKey, error) {
+	for _, f := range fragments {
+		if len(f.text) == 0 || len(f.text) > len(ciphertext) ||
+			f.offset != anyOffset && (f.offset < 0 || f.offset+len(f.text) > len(ciphertext)) {
+			return nil, ErrInvalidFragment
+		}
+	}
+	order := make([]int, len(fragments))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		fi, fj := fragments[order[i]], fragments[order[j]]
+		if (fi.offset == anyOffset) != (fj.offset == anyOffset) {
+			return fj.offset == anyOffset
+		}
+		return fi.offset == anyOffset && len(fi.text) > len(fj.text)
+	})
+	var found []partialKey
+	for keySize := 1; keySize <= maxKeySize; keySize++ {
+		pk := partialKey{make([]byte, keySize), make([]bool, keySize), make([]int, len(fragments)), 0}
+		for i := range pk.offsets {
+			pk.offsets[i] = anyOffset
+		}
+		keys := []partialKey{pk}
+		for _, i := range order {
+			keys = placeFragment(ciphertext, fragments[i], i, keys)
+		}
+		unchecked := 0
+		for _, pk := range keys {
+			if pk.checked > 0 {
+				found = append(found, pk)
+			} else if unchecked < maxPartialKeys && countKnown(pk.known) > 0 {
+				found = append(found, pk)
+				unchecked++
+			}
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		if found[i].checked != found[j].checked {
+			return found[i].checked > found[j].checked
+		}
+		if len(found[i].key) != len(found[j].key) {
+			return len(found[i].key) < len(found[j].key)
+		}
+		return countKnown(found[i].known) > countKnown(found[j].known)
+	})
+	var keys []partialKey
+	seen := make(map[string]bool)
+	for _, pk := range found {
+		id := "full " + string(keyPeriod(pk.key))
+		if countKnown(pk.known) < len(pk.key) {
+			id = "partial " + string(pk.key) + string(boolBytes(pk.known))
+		}
+		if !seen[id] {
+			seen[id] = true
+			keys = append(keys, pk)
+		}
+		if len(keys) == maxPartialKeys {
+			break
+		}
+	}
+	return keys, nil
+}
+
+// placeFragment places f, fragment i, in each of keys wherever it fits, as
+// described at recoverRepeatingKeyXor. Keys that f doesn't fit are dropped,
+// unless f may be anywhere and so is left unplaced.
+func placeFragment(ciphertext []byte, f knownPlaintext, i int, keys []partialKey) []partialKey {
+	if len(keys) == 0 {
+		return keys
+	}
+	var index *placementIndex
+	if f.offset == anyOffset {
+		index = newPlacementIndex(ciphertext, f.text, len(keys[0].key))
+	}
+	var next, unchecked []partialKey
+	worst := 0 // Checked bytes of the last key kept when next was last cut down
+	for _, pk := range keys {
+		anchor := f.offset == anyOffset && countKnown(pk.known) == 0
+		var offsets []int
+		switch {
+		case f.offset != anyOffset:
+			offsets = []int{f.offset}
+		case anchor:
+			offsets = make([]int, len(ciphertext)-len(f.text)+1)
+			for offset := range offsets {
+				offsets[offset] = offset
+			}
+		default:
+			offsets = index.candidates(pk)
+		}
+		placed := false
+		for _, offset := range offsets {
+			checked, ok := checkPlacement(ciphertext, f.text, offset, pk)
+			if !ok || f.offset == anyOffset && checked == 0 && !anchor {
+				continue
+			}
+			placed = true
+			if total := pk.checked + checked; total == 0 && len(unchecked) == maxPartialKeys || total > 0 && total <= worst {
+				// It wouldn't be kept anyway.
+				continue
+			}
+			placedKey := partialKey{
+				append([]byte(nil), pk.key...),
+				append([]bool(nil), pk.known...),
+				append([]int(nil), pk.offsets...),
+				pk.checked + checked,
+			}
+			keySize := len(pk.key)
+			for j, c := range f.text {
+				pos := (offset + j) % keySize
+				placedKey.key[pos], placedKey.known[pos] = ciphertext[offset+j]^c, true
+			}
+			placedKey.offsets[i] = offset
+			if placedKey.checked > 0 {
+				if next = append(next, placedKey); len(next) == 2*maxPartialKeys {
+					next = mostChecked(next)
+					worst = next[len(next)-1].checked
+				}
+			} else {
+				unchecked = append(unchecked, placedKey)
+			}
+		}
+		if !placed && f.offset == anyOffset {
+			if pk.checked > 0 {
+				next = append(next, pk)
+			} else if len(unchecked) < maxPartialKeys {
+				unchecked = append(unchecked, pk)
+			}
+		}
+	}
+	// Keys without checked bytes come from placing the first fragment at
+	// anyOffset, one for each offset, and nothing ranks them, so the first
+	// ones are kept. Each of them is tried against every later fragment, so
+	// keeping them all would make the search quadratic in the ciphertext.
+	return append(mostChecked(next), unchecked...)
+}
+
+// mostChecked keeps the maxPartialKeys keys with the most checked bytes,
+// in order of checked bytes and then of keys.
+func mostChecked(keys []partialKey) []partialKey {
+	sort.SliceStable(keys, func(i, j int) bool {
+		return keys[i].checked > keys[j].checked
+	})
+	if len(keys) > maxPartialKeys {
+		keys = keys[:maxPartialKeys]
+	}
+	return keys
+}
+
+// placementIndex holds the offsets at which a fragment gives each byte at
+// each position of a key of keySize bytes, so that a fragment at anyOffset is
+// only tried where it lands on a known key byte that it agrees with rather
+// than at every offset for every key.
+type placementIndex struct {
+	keySize int
+	span    int     // Fragment bytes that give distinct key positions
+	offsets [][]int // By position*256 + key byte, ascending
+	seen    []int   // Last call of candidates that found each offset
+	calls   int
+}
+
+func newPlacementIndex(ciphertext, text []byte, keySize int) *placementIndex {
+	x := &placementIndex{
+		keySize: keySize,
+		span:    min(len(text), keySize),
+		offsets: make([][]int, keySize*256),
+		seen:    make([]int, len(ciphertext)),
+	}
+	for offset := 0; offset+len(text) <= len(ciphertext); offset++ {
+		for j := 0; j < x.span; j++ {
+			b := (offset+j)%keySize*256 + int(ciphertext[offset+j]^text[j])
+			x.offsets[b] = append(x.offsets[b], offset)
+		}
+	}
+	return x
+}
+
+// candidates returns the offsets at which the fragment agrees with
+// at least one of the known bytes of pk. Every other offset is either wrong
+// or checked nowhere. A fragment that spans the whole key lands on every
+// known byte, so looking up the first is enough.
+func (x *placementIndex) candidates(pk partialKey) []int {
+	x.calls++
+	var offsets []int
+	for pos, known := range pk.known {
+		if !known {
+			continue
+		}
+		for _, offset := range x.offsets[pos*256+int(pk.key[pos])] {
+			if x.seen[offset] != x.calls {
+				x.seen[offset] = x.calls
+				offsets = append(offsets, offset)
+			}
+		}
+		if x.span == x.keySize {
+			break
+		}
+	}
+	return offsets
+}
+
+// checkPlacement reports whether text at offset agrees with the known bytes
+// of pk, and with itself where it wraps around the key, and how many of its
+// bytes were checked that way.
+func checkPlacement(ciphertext, text []byte, offset int, pk partialKey) (int, bool) {
+	keySize := len(pk.key)
+	checked := 0
+	for j, c := range text {
+		pos := (offset + j) % keySize
+		k := ciphertext[offset+j] ^ c
+		switch {
+		case pk.known[pos]:
+			if pk.key[pos] != k {
+				return 0, false
+			}
+		case j >= keySize:
+			if ciphertext[offset+j-keySize]^text[j-keySize] != k {
+				return 0, false
+			}
+		default:
+			continue
+		}
+		checked++
+	}
+	return checked, true
+}
+
+// completeKey fills in the bytes of pk that aren't known by cracking their
+// columns of ciphertext as single-byte XOR.
+func completeKey(ciphertext []byte, pk partialKey, s scorer) []byte {
+	key := append([]byte(nil), pk.key...)
+	columns := transposeColumns(ciphertext, len(key))
+	for i := range key {
+		if !pk.known[i] {
+			_, _, key[i] = crackSingleCharXor(columns[i], s)
+		}
+	}
+	return key
+}
+
+func countKnown(known []bool) int {
+	n := 0
+	for _, k := range known {
+		if k {
+			n++
+		}
+	}
+	return n
+}
+
+func boolBytes(bs []bool) []byte {
+	out := make([]byte, len(bs))
+	for i, b := range bs {
+		if b {
+			out[i] = 1
+		}
+	}
+	return out
+}
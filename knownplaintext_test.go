@@ -0,0 +1,175 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"testing"
+	"time"
+)
+
+func TestRecoverRepeatingKeyXorFixedOffset(t *testing.T) {
+	plaintext := []byte(`{"user": "alice", "admin": false, "quota": 1024}`)
+	ciphertext, _ := repeatingKeyXor(plaintext, []byte("s3cr3t"))
+	keys, err := recoverRepeatingKeyXor(ciphertext, []knownPlaintext{{0, []byte(`{"user": "`)}}, 8)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The fragment is 10 bytes, so it wraps around every key size up to 8
+	// and only 6 gives the same key bytes both times.
+	if len(keys) != 1 {
+		t.Fatalf("got %d keys, want 1: %v", len(keys), keys)
+	}
+	if string(keys[0].key) != "s3cr3t" || countKnown(keys[0].known) != 6 || keys[0].offsets[0] != 0 {
+		t.Errorf("keys[0] = %q", keys[0].key)
+	}
+	// Longer keys aren't wrapped around, so there is nothing to check the
+	// fragment against and they come after it.
+	keys, err = recoverRepeatingKeyXor(ciphertext, []knownPlaintext{{0, []byte(`{"user": "`)}}, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 4 || string(keys[0].key) != "s3cr3t" || keys[0].checked != 4 {
+		t.Fatalf("got %d keys, want 4: %v", len(keys), keys)
+	}
+	for i, pk := range keys[1:] {
+		if len(pk.key) != 10+i || pk.checked != 0 || countKnown(pk.known) != 10 {
+			t.Errorf("keys[%d] = %v, want an unchecked key of size %d", i+1, pk, 10+i)
+		}
+	}
+}
+
+func TestRecoverRepeatingKeyXorAnyOffset(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plaintext := corpus[:2000]
+	key := []byte("Terminator X")
+	ciphertext, _ := repeatingKeyXor(plaintext, key)
+	fragments := []knownPlaintext{
+		{anyOffset, []byte("it becomes necessary for one people")},
+		{anyOffset, []byte("We hold these truths to be self-evident")},
+	}
+	keys, err := recoverRepeatingKeyXor(ciphertext, fragments, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) != 1 || string(keys[0].key) != string(key) {
+		t.Fatalf("keys = %v", keys)
+	}
+	if keys[0].offsets[0] != 36 {
+		t.Errorf("first fragment placed at %d, want 36", keys[0].offsets[0])
+	}
+}
+
+func TestRecoverRepeatingKeyXorShortFragments(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, _ := repeatingKeyXor(corpus, []byte("Terminator X"))
+	// A short fragment that could be anywhere only wraps around keys shorter
+	// than itself, and is checked nowhere else.
+	keys, err := recoverRepeatingKeyXor(ciphertext, []knownPlaintext{{anyOffset, []byte("the ")}}, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) == 0 || len(keys) > maxPartialKeys || keys[len(keys)-1].checked != 0 {
+		t.Fatalf("got %d keys", len(keys))
+	}
+	for i, pk := range keys {
+		if pk.checked > 0 && len(pk.key) >= 4 {
+			t.Errorf("got checked key of size %d", len(pk.key))
+		}
+		if i > 0 && pk.checked > keys[i-1].checked {
+			t.Errorf("keys[%d] has more checked bytes than keys[%d]", i, i-1)
+		}
+	}
+
+	// Two such fragments over the whole corpus land on the same key bytes at
+	// many pairs of offsets.
+	start := time.Now()
+	keys, err = recoverRepeatingKeyXor(ciphertext, []knownPlaintext{{anyOffset, []byte("the ")}, {anyOffset, []byte(" and ")}}, 40)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) > maxPartialKeys {
+		t.Errorf("got %d keys", len(keys))
+	}
+	if elapsed := time.Since(start); elapsed > 20*time.Second {
+		t.Errorf("took %v over %d bytes", elapsed, len(ciphertext))
+	}
+
+	// Two short fragments that land on some of the same key bytes.
+	ciphertext = ciphertext[:3000]
+	fragments := []knownPlaintext{
+		{anyOffset, corpus[100:110]},
+		{anyOffset, corpus[206:216]},
+	}
+	keys, err = recoverRepeatingKeyXor(ciphertext, fragments, 12)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(keys) == 0 || len(keys) > maxPartialKeys {
+		t.Fatalf("got %d keys", len(keys))
+	}
+	pk := keys[0]
+	if len(pk.key) != 12 || pk.checked != 8 || pk.offsets[0] != 100 || pk.offsets[1] != 206 ||
+		countKnown(pk.known) != 12 || string(pk.key) != "Terminator X" {
+		t.Errorf("keys[0] = %v", pk)
+	}
+}
+
+func TestCompleteKey(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	key := []byte("a longer secret key")
+	ciphertext, _ := repeatingKeyXor(corpus, key)
+	// The second fragment lands on the first two key bytes again.
+	fragments := []knownPlaintext{{0, corpus[:5]}, {len(key), corpus[len(key) : len(key)+2]}}
+	keys, err := recoverRepeatingKeyXor(ciphertext, fragments, len(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var pk partialKey
+	for _, k := range keys {
+		if len(k.key) == len(key) {
+			pk = k
+		}
+	}
+	if pk.checked != 2 || countKnown(pk.known) != 5 {
+		t.Fatalf("key of size %d = %q, %d known", len(key), pk.key, countKnown(pk.known))
+	}
+	if got := completeKey(ciphertext, pk, logLikelihoodScorer{}); string(got) != string(key) {
+		t.Errorf("completeKey = %q, want %q", got, key)
+	}
+
+	// A header shorter than the key checks nothing but still gives its start.
+	keys, err = recoverRepeatingKeyXor(ciphertext, []knownPlaintext{{0, corpus[:8]}}, len(key))
+	if err != nil {
+		t.Fatal(err)
+	}
+	pk = keys[len(keys)-1]
+	if len(pk.key) != len(key) || pk.checked != 0 || countKnown(pk.known) != 8 {
+		t.Fatalf("last key = %q, %d checked, %d known", pk.key, pk.checked, countKnown(pk.known))
+	}
+	if got := completeKey(ciphertext, pk, logLikelihoodScorer{}); string(got) != string(key) {
+		t.Errorf("completeKey = %q, want %q", got, key)
+	}
+}
+
+func TestRecoverRepeatingKeyXorInvalid(t *testing.T) {
+	ciphertext := []byte("0123456789")
+	for _, f := range []knownPlaintext{
+		{0, nil},
+		{anyOffset, []byte("01234567890")},
+		{-2, []byte("a")},
+		{8, []byte("abc")},
+	} {
+		if _, err := recoverRepeatingKeyXor(ciphertext, []knownPlaintext{f}, 4); !errors.Is(err, ErrInvalidFragment) {
+			t.Errorf("fragment %v: got '%v', want '%v'", f, err, ErrInvalidFragment)
+		}
+	}
+}
@@ -0,0 +1,193 @@
+package main
+
+import (
+	"math"
+	"unicode"
+	"unicode/utf8"
+)
+
+// langProfile is a scorer for text in one language. Text is decoded as UTF-8
+// and scored like logLikelihoodScorer does, with the language's own letter
+// frequencies, including letters outside ASCII. The score is per byte, so
+// that candidates of the same length compare fairly whatever their encoding.
+type langProfile struct {
+	code, name string
+	ascii      [128]float32     // Log probabilities of ASCII bytes
+	letters    map[rune]float32 // Log probabilities of other letters
+}
+
+// Probabilities of runes that the profile knows nothing about: letters from
+// other alphabets, and bytes that are neither printable ASCII nor valid UTF-8.
+const (
+	langForeignLetter = 0.00001
+	langInvalid       = llOther / 256
+)
+
+// Letter frequencies in percent, taken from Wikipedia. The English ones are
+// letterFreq.
+var (
+	englishProfile = newLangProfile("en", "English", func() map[rune]float32 {
+		freq := make(map[rune]float32, len(letterFreq))
+		for c, f := range letterFreq {
+			freq[rune(c)] = f
+		}
+		return freq
+	}())
+	germanProfile = newLangProfile("de", "German", map[rune]float32{
+		'a': 6.516, 'b': 1.886, 'c': 2.732, 'd': 5.076, 'e': 16.396,
+		'f': 1.656, 'g': 3.009, 'h': 4.577, 'i': 6.550, 'j': 0.268,
+		'k': 1.417, 'l': 3.437, 'm': 2.534, 'n': 9.776, 'o': 2.594,
+		'p': 0.670, 'q': 0.018, 'r': 7.003, 's': 7.270, 't': 6.154,
+		'u': 4.166, 'v': 0.846, 'w': 1.921, 'x': 0.034, 'y': 0.039,
+		'z': 1.134, 'ä': 0.578, 'ö': 0.443, 'ü': 0.995, 'ß': 0.307,
+	})
+	swedishProfile = newLangProfile("sv", "Swedish", map[rune]float32{
+		'a': 9.383, 'b': 1.535, 'c': 1.486, 'd': 4.702, 'e': 10.149,
+		'f': 2.027, 'g': 2.862, 'h': 2.090, 'i': 5.817, 'j': 0.614,
+		'k': 3.140, 'l': 5.275, 'm': 3.471, 'n': 8.542, 'o': 4.482,
+		'p': 1.839, 'q': 0.020, 'r': 8.431, 's': 6.590, 't': 7.691,
+		'u': 1.919, 'v': 2.415, 'w': 0.142, 'x': 0.159, 'y': 0.708,
+		'z': 0.070, 'å': 1.338, 'ä': 1.797, 'ö': 1.305,
+	})
+	frenchProfile = newLangProfile("fr", "French", map[rune]float32{
+		'a': 7.636, 'b': 0.901, 'c': 3.260, 'd': 3.669, 'e': 14.715,
+		'f': 1.066, 'g': 0.866, 'h': 0.737, 'i': 7.529, 'j': 0.613,
+		'k': 0.049, 'l': 5.456, 'm': 2.968, 'n': 7.095, 'o': 5.796,
+		'p': 2.521, 'q': 1.362, 'r': 6.693, 's': 7.948, 't': 7.244,
+		'u': 6.311, 'v': 1.838, 'w': 0.074, 'x': 0.427, 'y': 0.128,
+		'z': 0.326, 'à': 0.486, 'â': 0.051, 'ç': 0.085, 'è': 0.271,
+		'é': 1.504, 'ê': 0.218, 'ë': 0.008, 'î': 0.045, 'ï': 0.005,
+		'ô': 0.023, 'œ': 0.018, 'ù': 0.058, 'û': 0.060,
+	})
+	spanishProfile = newLangProfile("es", "Spanish", map[rune]float32{
+		'a': 11.525, 'b': 2.215, 'c': 4.019, 'd': 5.010, 'e': 12.181,
+		'f': 0.692, 'g': 1.768, 'h': 0.703, 'i': 6.247, 'j': 0.493,
+		'k': 0.011, 'l': 4.967, 'm': 3.157, 'n': 6.712, 'o': 8.683,
+		'p': 2.510, 'q': 0.877, 'r': 6.871, 's': 7.977, 't': 4.632,
+		'u': 2.927, 'v': 1.138, 'w': 0.017, 'x': 0.215, 'y': 1.008,
+		'z': 0.467, 'á': 0.502, 'é': 0.433, 'í': 0.725, 'ñ': 0.311,
+		'ó': 0.827, 'ú': 0.168, 'ü': 0.012,
+	})
+)
+
+// langProfiles are all the built-in profiles, English first.
+var langProfiles = anyLanguage{englishProfile, germanProfile, swedishProfile, frenchProfile, spanishProfile}
+
+// newLangProfile builds a profile from lowercase letter frequencies in any
+// unit. Uppercase is assumed to be ten times rarer than lowercase.
+func newLangProfile(code, name string, freq map[rune]float32) *langProfile {
+	var total float64
+	for _, f := range freq {
+		total += float64(f)
+	}
+	var printable int
+	for c := 0; c < 128; c++ {
+		if c != ' ' && !unicode.IsLetter(rune(c)) && isPrintable(byte(c)) {
+			printable++
+		}
+	}
+	p := &langProfile{code: code, name: name, letters: make(map[rune]float32)}
+	for c := 0; c < 128; c++ {
+		switch {
+		case c == ' ':
+			p.ascii[c] = float32(math.Log(llSpace))
+		case unicode.IsLetter(rune(c)):
+			p.ascii[c] = float32(math.Log(langForeignLetter))
+		case isPrintable(byte(c)):
+			p.ascii[c] = float32(math.Log(llPrintable / float64(printable)))
+		default:
+			p.ascii[c] = float32(math.Log(langInvalid))
+		}
+	}
+	for r, f := range freq {
+		x := llLetters * float64(f) / total
+		lower := float32(math.Log(x * 10 / 11))
+		upper := float32(math.Log(x / 11))
+		if r < utf8.RuneSelf {
+			p.ascii[r] = lower
+		} else {
+			p.letters[r] = lower
+		}
+		if u := unicode.ToUpper(r); u != r && u < utf8.RuneSelf {
+			p.ascii[u] = upper
+		} else if u != r {
+			p.letters[u] = upper
+		}
+	}
+	return p
+}
+
+func (p *langProfile) score(bytes []byte) float32 {
+	if len(bytes) == 0 {
+		return 0
+	}
+	var sum float32
+	for i := 0; i < len(bytes); {
+		if c := bytes[i]; c < utf8.RuneSelf {
+			sum += p.ascii[c]
+			i++
+			continue
+		}
+		r, size := utf8.DecodeRune(bytes[i:])
+		switch lp, ok := p.letters[r]; {
+		case r == utf8.RuneError:
+			sum += float32(math.Log(langInvalid))
+		case ok:
+			sum += lp
+		case unicode.IsLetter(r):
+			sum += float32(math.Log(langForeignLetter))
+		case unicode.IsPrint(r) || unicode.IsSpace(r):
+			sum += float32(math.Log(llPrintable / 64))
+		default:
+			sum += float32(math.Log(langInvalid))
+		}
+		i += size
+	}
+	return -sum / float32(len(bytes))
+}
+
+// anyLanguage is a scorer that scores with each of its profiles and keeps the
+// best, for plaintext that may be in any of the languages.
+type anyLanguage []*langProfile
+
+func (ls anyLanguage) score(bytes []byte) float32 {
+	_, score := ls.detect(bytes)
+	return score
+}
+
+// detect returns the profile that bytes score best against, and the score.
+func (ls anyLanguage) detect(bytes []byte) (*langProfile, float32) {
+	var best *langProfile
+	var bestScore float32 = math.MaxFloat32
+	for _, p := range ls {
+		if score := p.score(bytes); score < bestScore {
+			best, bestScore = p, score
+		}
+	}
+	return best, bestScore
+}
+
+// languageDetector is a scorer that can also say which language a plaintext
+// matched, as anyLanguage does. The crackers report the language when their
+// scorer is one.
+type languageDetector interface {
+	scorer
+	detect(bytes []byte) (*langProfile, float32)
+}
+
+// detectedLanguage is the language plaintext matched if s is a
+// languageDetector, or nil otherwise.
+func detectedLanguage(s scorer, plaintext []byte) *langProfile {
+	ld, ok := s.(languageDetector)
+	if !ok {
+		return nil
+	}
+	p, _ := ld.detect(plaintext)
+	return p
+}
+
+// detectLanguage reports which of the built-in languages text is most likely
+// in.
+func detectLanguage(text []byte) (*langProfile, float32) {
+	return langProfiles.detect(text)
+}
@@ -0,0 +1,83 @@
+package main
+
+import (
+	"context"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+var languageSamples = []struct {
+	code, text string
+}{
+	{"en", "The old harbour was quiet in the morning, and the fishermen were already mending their nets while the gulls circled above the water."},
+	{"de", "Der alte Hafen war am Morgen still, und die Fischer flickten schon ihre Netze, während die Möwen über dem Wasser kreisten und grüßten."},
+	{"sv", "Den gamla hamnen var tyst på morgonen, och fiskarna lagade redan sina nät medan måsarna cirklade över vattnet och skrek högt."},
+	{"fr", "Le vieux port était calme le matin, et les pêcheurs réparaient déjà leurs filets pendant que les mouettes tournaient au-dessus de l'eau."},
+	{"es", "El viejo puerto estaba tranquilo por la mañana, y los pescadores ya remendaban sus redes mientras las gaviotas volaban sobre el agua."},
+}
+
+func TestDetectLanguage(t *testing.T) {
+	for _, sample := range languageSamples {
+		p, _ := detectLanguage([]byte(sample.text))
+		if p.code != sample.code {
+			t.Errorf("detectLanguage(%q) = %s, want %s", sample.text, p.code, sample.code)
+		}
+	}
+}
+
+func TestLangProfilesPreferText(t *testing.T) {
+	for _, sample := range languageSamples {
+		text := []byte(sample.text)
+		noise := make([]byte, len(text))
+		rand.Read(noise)
+		for _, p := range langProfiles {
+			if p.score(text) >= p.score(noise) {
+				t.Errorf("%s: score(%s sample) = %v, score(noise) = %v", p.code, sample.code, p.score(text), p.score(noise))
+			}
+		}
+	}
+	for _, p := range langProfiles {
+		if got := p.score(nil); got != 0 {
+			t.Errorf("%s: score(nil) = %v, want 0", p.code, got)
+		}
+	}
+}
+
+func TestCrackSingleCharXorLanguages(t *testing.T) {
+	for i, sample := range languageSamples {
+		key := byte(0x41 + 17*i)
+		input, _ := repeatingKeyXor([]byte(sample.text), []byte{key})
+		res := crackSingleCharXorRanked(input, langProfiles, 1)
+		best := res.candidates[0]
+		if string(best.plaintext) != sample.text || best.key != key {
+			t.Errorf("%s: crackSingleCharXorRanked = %q, %#02x, want key %#02x", sample.code, best.plaintext, best.key, key)
+			continue
+		}
+		if best.language == nil || best.language.code != sample.code {
+			t.Errorf("%s: detected %v", sample.code, best.language)
+		}
+	}
+	// Scorers that don't know about languages leave it unset.
+	if res := crackSingleCharXorRanked([]byte("plain text"), englishBytes, 1); res.candidates[0].language != nil {
+		t.Errorf("englishBytes detected %s", res.candidates[0].language.code)
+	}
+	// Nor is it detected for the columns of longer keys.
+	input, _ := repeatingKeyXor([]byte(languageSamples[0].text), []byte{0x41})
+	if res, _ := crackSingleCharXorAllowed(input, langProfiles, nil, nil, 1); res.candidates[0].language != nil {
+		t.Errorf("crackSingleCharXorAllowed detected %s", res.candidates[0].language.code)
+	}
+}
+
+func TestCrackRepeatingKeyXorLanguage(t *testing.T) {
+	sample := languageSamples[1]
+	text := []byte(strings.Repeat(sample.text+" ", 8))
+	input, _ := repeatingKeyXor(text, []byte("Schl\xfcssel"))
+	res, err := crackRepeatingKeyXor(context.Background(), input, langProfiles, xorOptions{maxKeySize: 12})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.language == nil || res.language.code != sample.code {
+		t.Errorf("detected %v in %q", res.language, res.plaintext)
+	}
+}
@@ -0,0 +1,281 @@
+package main
+
+import (
+	"errors"
+	"io/ioutil"
+	"strings"
+)
+
+type inputFormat int
+
+const (
+	inputBinary inputFormat = iota
+	inputHex
+	inputBase64
+	inputPEM
+)
+
+var ErrInvalidPEM = errors.New("decodeInput: missing PEM end line")
+
+func (f inputFormat) String() string {
+	switch f {
+	case inputBinary:
+		return "binary"
+	case inputHex:
+		return "hex"
+	case inputBase64:
+		return "base64"
+	case inputPEM:
+		return "PEM"
+	default:
+		return "unknown"
+	}
+}
+
+// loadFile reads filename and decodes it as whatever sniffFormat finds it to
+// be. Text that is neither hex, base64 nor PEM is returned as is.
+func loadFile(filename string) ([]byte, inputFormat, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, inputBinary, err
+	}
+	format := sniffFormat(data)
+	bytes, err := decodeInput(data, format)
+	return bytes, format, err
+}
+
+// loadFileAs reads filename and decodes it as format.
+func loadFileAs(filename string, format inputFormat) ([]byte, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return decodeInput(data, format)
+}
+
+func sniffFormat(data []byte) inputFormat {
+	s := string(data)
+	if strings.Contains(s, "-----BEGIN ") {
+		return inputPEM
+	}
+	isHex, isBase64 := true, true
+	n := 0
+	for _, c := range data {
+		switch {
+		case c == '\r' || c == '\n':
+			continue
+		case c == ' ' || c == '\t':
+			// Hex may be spaced out, base64 is not.
+			isBase64 = false
+			continue
+		case c < 0x20 || c >= 0x7f:
+			return inputBinary
+		case strings.IndexByte(base64Alphabet, c) == -1 && strings.IndexByte(base64URLAlphabet, c) == -1 && c != '=':
+			isBase64 = false
+			isHex = false
+		case strings.IndexByte("0123456789abcdefABCDEF", c) == -1:
+			isHex = false
+		}
+		n++
+	}
+	switch {
+	case n == 0:
+		return inputBinary
+	case isHex && n%2 == 0 && looksLikeHex(textLines(s), n):
+		return inputHex
+	case isBase64 && looksLikeBase64(textLines(s), n):
+		if _, err := decodeInput(data, inputBase64); err == nil {
+			return inputBase64
+		}
+	}
+	return inputBinary
+}
+
+// looksLikeHex is looksLikeBase64 for hex, which short words and numbers
+// easily pass for: it wants bytes or words spaced out evenly, lines wrapped
+// at the same width or a length of at least encodedSniffLen.
+func looksLikeHex(lines lineSet, n int) bool {
+	var fields lineSet
+	for _, l := range lines {
+		fields = append(fields, l.fields()...)
+	}
+	if len(fields) >= 4 {
+		spaced := true
+		for _, f := range fields {
+			spaced = spaced && len(f.text) == len(fields[0].text)
+		}
+		if spaced {
+			return true
+		}
+	}
+	if width, ok := lines.wrapWidth(); ok && width >= 16 {
+		return true
+	}
+	return n >= encodedSniffLen
+}
+
+// encodedSniffLen is how long text without spaces must be to be taken as
+// base64, or hex, with nothing else to go on.
+const encodedSniffLen = 32
+
+// looksLikeBase64 wants more than the right alphabet, as plenty of short text
+// is valid base64 too: padding, lines wrapped at the same width or a length
+// that text without spaces rarely reaches. n is the number of characters in
+// lines.
+func looksLikeBase64(lines lineSet, n int) bool {
+	for _, l := range lines {
+		if strings.HasSuffix(l.text, "=") && n%4 == 0 && n >= 8 {
+			return true
+		}
+	}
+	if width, ok := lines.wrapWidth(); ok && width%4 == 0 && width >= 16 {
+		return true
+	}
+	return n >= encodedSniffLen
+}
+
+// wrapWidth reports whether there are several lines that all but the last
+// have the same width, which the last doesn't go over.
+func (lines lineSet) wrapWidth() (int, bool) {
+	if len(lines) < 2 {
+		return 0, false
+	}
+	width := len(lines[0].text)
+	for _, l := range lines[:len(lines)-1] {
+		if len(l.text) != width {
+			return 0, false
+		}
+	}
+	return width, len(lines[len(lines)-1].text) <= width
+}
+
+// decodeInput decodes data as format. Hex and base64 may be wrapped or have
+// one encoded value per line, and base64 may be in any variant. Errors carry
+// the line they were found on.
+func decodeInput(data []byte, format inputFormat) ([]byte, error) {
+	switch format {
+	case inputHex:
+		var fields lineSet
+		for _, l := range textLines(string(data)) {
+			fields = append(fields, l.fields()...)
+		}
+		return fields.decode("hex", func(s string) ([]byte, error) {
+			return fromHexString(hex(strings.ToLower(s)))
+		})
+	case inputBase64:
+		return decodeBase64Lines(textLines(string(data)))
+	case inputPEM:
+		return decodePEM(string(data))
+	default:
+		return data, nil
+	}
+}
+
+// decodePEM decodes the body of the first PEM block in s, skipping any
+// headers.
+func decodePEM(s string) ([]byte, error) {
+	lines := textLines(s)
+	begin := -1
+	for i, l := range lines {
+		if begin == -1 && strings.HasPrefix(l.text, "-----BEGIN ") {
+			begin = i + 1
+			continue
+		}
+		if begin != -1 && strings.HasPrefix(l.text, "-----END ") {
+			body := lines[begin:i]
+			for len(body) > 0 && strings.Contains(body[0].text, ":") {
+				body = body[1:]
+			}
+			return decodeBase64Lines(body)
+		}
+	}
+	return nil, ErrInvalidPEM
+}
+
+// decodeBase64Lines joins wrapped base64 before decoding it, or decodes each
+// line on its own if padding shows up before the last line.
+func decodeBase64Lines(lines lineSet) ([]byte, error) {
+	enc := stdBase64.lenient()
+	for _, l := range lines {
+		if strings.ContainsAny(l.text, "-_") {
+			enc = urlBase64.lenient()
+			break
+		}
+	}
+	decode := func(s string) ([]byte, error) {
+		return enc.decode(base64(s))
+	}
+	for i, l := range lines {
+		if i < len(lines)-1 && strings.HasSuffix(l.text, "=") {
+			var out []byte
+			for _, l := range lines {
+				bytes, err := lineSet{l}.decode("base64", decode)
+				if err != nil {
+					return nil, err
+				}
+				out = append(out, bytes...)
+			}
+			return out, nil
+		}
+	}
+	return lines.decode("base64", decode)
+}
+
+type textLine struct {
+	text string
+	num  int // Line number, counting from 1
+	col  int // Offset of text in the line
+}
+
+type lineSet []textLine
+
+// textLines splits s into lines with surrounding whitespace trimmed, skipping
+// empty ones.
+func textLines(s string) lineSet {
+	var lines lineSet
+	for i, line := range strings.Split(s, "\n") {
+		text := strings.TrimSpace(line)
+		if text != "" {
+			lines = append(lines, textLine{text, i + 1, strings.Index(line, text)})
+		}
+	}
+	return lines
+}
+
+// fields splits l on whitespace.
+func (l textLine) fields() lineSet {
+	var fields lineSet
+	text, col := l.text, l.col
+	for _, f := range strings.Fields(l.text) {
+		i := strings.Index(text, f)
+		fields = append(fields, textLine{f, l.num, col + i})
+		text, col = text[i+len(f):], col+i+len(f)
+	}
+	return fields
+}
+
+// decode joins the lines and decodes them, pointing any decodeError back to
+// the line and offset within it where the failure happened.
+func (lines lineSet) decode(codec string, decode func(string) ([]byte, error)) ([]byte, error) {
+	var b strings.Builder
+	starts := make([]int, len(lines))
+	for i, l := range lines {
+		starts[i] = b.Len()
+		b.WriteString(l.text)
+	}
+	bytes, err := decode(b.String())
+	var derr *decodeError
+	if err != nil && errors.As(err, &derr) && len(lines) > 0 {
+		i := len(lines) - 1
+		for i > 0 && int64(starts[i]) > derr.offset {
+			i--
+		}
+		if derr.length {
+			i = len(lines) - 1
+		}
+		derr.codec = codec
+		derr.offset += int64(lines[i].col - starts[i])
+		derr.line = lines[i].num
+	}
+	return bytes, err
+}
@@ -0,0 +1,92 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+)
+
+func writeTempFile(t *testing.T, data []byte) string {
+	file, err := ioutil.TempFile("", "cryptopals")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	if _, err := file.Write(data); err != nil {
+		t.Fatal(err)
+	}
+	return file.Name()
+}
+
+func TestLoadFile(t *testing.T) {
+	want := make([]byte, 100000)
+	rand.Read(want)
+	want[0] = 0 // Make sure it doesn't look like text
+	short := want[:45]
+
+	var perLine strings.Builder
+	for i := 0; i < len(short); i += 10 {
+		perLine.WriteString(string(stdBase64.encode(short[i:min(i+10, len(short))])) + "\r\n")
+	}
+	pem := "-----BEGIN ENCRYPTED BLOB-----\nProc-Type: 4,ENCRYPTED\n\n" +
+		renderWrapped(string(stdBase64.encode(short)), 64) + "-----END ENCRYPTED BLOB-----\n"
+	tests := []struct {
+		data   string
+		want   []byte
+		format inputFormat
+	}{
+		{string(want), want, inputBinary},
+		{"plain text, not encoded\n", []byte("plain text, not encoded\n"), inputBinary},
+		{"Password\n", []byte("Password\n"), inputBinary},
+		{"hello\nworld\n", []byte("hello\nworld\n"), inputBinary},
+		{"added\nfaded\n", []byte("added\nfaded\n"), inputBinary},
+		{"2024\n", []byte("2024\n"), inputBinary},
+		{"cafe babe\n", []byte("cafe babe\n"), inputBinary},
+		{"de ad be ef\n", []byte{0xde, 0xad, 0xbe, 0xef}, inputHex},
+		{string(stdBase64.encode(short[:7])) + "\n", short[:7], inputBase64},
+		{renderWrapped(strings.ToUpper(string(toHexString(short))), 20), short, inputHex},
+		{renderHexdump(short, formatWireshark)[6:54], short[:16], inputHex},
+		{string(rawURLBase64.encode(want)) + "\n", want, inputBase64},
+		{renderWrapped(string(stdBase64.encode(short)), 60), short, inputBase64},
+		{perLine.String(), short, inputBase64},
+		{pem, short, inputPEM},
+	}
+	for _, test := range tests {
+		name := writeTempFile(t, []byte(test.data))
+		defer os.Remove(name)
+		got, format, err := loadFile(name)
+		if err != nil || format != test.format || !bytes.Equal(got, test.want) {
+			t.Errorf("loadFile(%.40q) = %.40q, %v, '%v', want %.40q, %v", test.data, got, format, err, test.want, test.format)
+		}
+	}
+}
+
+func TestLoadFileAsError(t *testing.T) {
+	name := writeTempFile(t, []byte("4d616e\n  4d61 6e2\n"))
+	defer os.Remove(name)
+	_, err := loadFileAs(name, inputHex)
+	var derr *decodeError
+	if !errors.Is(err, ErrInvalidHexLen) || !errors.As(err, &derr) || derr.line != 2 {
+		t.Errorf("loadFileAs = '%v', want '%v' on line 2", err, ErrInvalidHexLen)
+	}
+
+	name = writeTempFile(t, []byte("4d616e\n  4d61 6x6e\n"))
+	defer os.Remove(name)
+	_, err = loadFileAs(name, inputHex)
+	if !errors.Is(err, ErrInvalidHexChar) || !errors.As(err, &derr) || derr.line != 2 || derr.offset != 8 {
+		t.Errorf("loadFileAs = '%v', want '%v' at offset 8 on line 2", err, ErrInvalidHexChar)
+	}
+}
+
+// renderWrapped breaks s into lines of width characters.
+func renderWrapped(s string, width int) string {
+	var b strings.Builder
+	for i := 0; i < len(s); i += width {
+		b.WriteString(s[i:min(i+width, len(s))] + "\n")
+	}
+	return b.String()
+}
@@ -1,9 +1,11 @@
 package main // import "cryptopals"
 
 import (
-	"bufio"
+	"bytes"
+	"context"
 	"errors"
-	"math"
+	"fmt"
+	"math/bits"
 	"os"
 	"sort"
 	"strings"
@@ -15,8 +17,9 @@ type (
 )
 
 const (
-	hexAlphabet    = "0123456789abcdef"
-	base64Alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+	hexAlphabet       = "0123456789abcdef"
+	base64Alphabet    = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/"
+	base64URLAlphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_"
 )
 
 var (
@@ -24,11 +27,79 @@ var (
 	ErrInvalidHexChar = errors.New("fromHexString: invalid input char")
 	ErrInvalidB64Len  = errors.New("fromBase64String: invalid input length")
 	ErrInvalidB64Char = errors.New("fromBase64String: invalid input char")
+	ErrInvalidB64Pad  = errors.New("fromBase64String: invalid padding")
+	ErrInvalidB64Bits = errors.New("fromBase64String: non-zero trailing bits")
 	ErrDiffInputLen   = errors.New("different length of args")
+	ErrEmptyKey       = errors.New("repeatingKeyXor: empty key")
+	ErrShortDst       = errors.New("destination shorter than input")
+	ErrInvalidKeySize = errors.New("key size must be at least 1")
+	ErrEmptyHexLine   = errors.New("crackSingleCharXorHexs: empty line")
 )
 
+// decodeError records where decoding failed. It wraps one of the ErrInvalid
+// sentinels above, so errors.Is still matches them.
+type decodeError struct {
+	codec  string // Name of the encoding, e.g. "hex"
+	offset int64  // Offset of char, or the input length for length errors
+	char   byte   // Offending input byte, unset for length errors
+	length bool   // Set for length errors
+	line   int    // Line number, when set offset is relative to the line
+	err    error
+}
+
+func (e *decodeError) Error() string {
+	var b strings.Builder
+	if e.codec != "" {
+		fmt.Fprintf(&b, "%s: ", e.codec)
+	}
+	fmt.Fprintf(&b, "%v", e.err)
+	if !e.length {
+		fmt.Fprintf(&b, " %q", e.char)
+	}
+	fmt.Fprintf(&b, " at offset %d", e.offset)
+	if e.line > 0 {
+		fmt.Fprintf(&b, " on line %d", e.line)
+	}
+	return b.String()
+}
+
+func (e *decodeError) Unwrap() error {
+	return e.err
+}
+
+// charError reports the invalid byte at offset i of s.
+func charError(codec string, err error, s string, i int) error {
+	return &decodeError{codec: codec, offset: int64(i), char: s[i], err: err}
+}
+
+// lenError reports that s has an invalid length.
+func lenError(codec string, err error, s string) error {
+	return &decodeError{codec: codec, offset: int64(len(s)), length: true, err: err}
+}
+
+const usage = `usage: cryptopals command [arguments]
+
+Commands:
+  cribdrag [-n N] file...  crib drag ciphertexts that share a keystream
+`
+
 func main() {
-	// ...
+	if len(os.Args) < 2 {
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	var err error
+	switch cmd, args := os.Args[1], os.Args[2:]; cmd {
+	case "cribdrag":
+		err = runCribDrag(args, os.Stdin, os.Stdout)
+	default:
+		fmt.Fprint(os.Stderr, usage)
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
 }
 
 func absFloat32(x float32) float32 {
@@ -47,32 +118,21 @@ func byteFromHex(x byte) byte {
 	return byte(i)
 }
 
-// Valid max output is 63, returns 255 on error.
-func byteFromBase64(x byte) byte {
-	switch x {
-	case '=':
-		return 0
-	default:
-		i := strings.IndexByte(base64Alphabet, x)
-		if i == -1 {
-			return 255
-		}
-		return byte(i)
-	}
-}
-
 func fromHexString(s hex) ([]byte, error) {
 	length := len(s)
 	if length%2 != 0 {
-		return nil, ErrInvalidHexLen
+		return nil, lenError("hex", ErrInvalidHexLen, string(s))
 	}
 	n := length / 2
 	bytes := make([]byte, n)
 	for i := 0; i < n; i++ {
 		a := byteFromHex(s[2*i])
 		b := byteFromHex(s[2*i+1])
-		if a > 15 || b > 15 {
-			return nil, ErrInvalidHexChar
+		if a > 15 {
+			return nil, charError("hex", ErrInvalidHexChar, string(s), 2*i)
+		}
+		if b > 15 {
+			return nil, charError("hex", ErrInvalidHexChar, string(s), 2*i+1)
 		}
 		bytes[i] = (a << 4) | b
 	}
@@ -80,101 +140,55 @@ func fromHexString(s hex) ([]byte, error) {
 }
 
 func fromBase64String(s base64) ([]byte, error) {
-	// TODO Support unpadded input and check that padding only occurs at the end.
-	length := len(s)
-	if length%4 != 0 {
-		return nil, ErrInvalidB64Len
-	}
-	padding := 0
-	switch {
-	case length < 4:
-		// do nothing
-	case s[length-2] == '=':
-		padding = 2
-	case s[length-1] == '=':
-		padding = 1
-	}
-	chunks := length / 4
-	nbytes := 3 * chunks
-	bytes := make([]byte, nbytes)
-	for i := 0; i < chunks; i++ {
-		a := byteFromBase64(s[4*i])
-		b := byteFromBase64(s[4*i+1])
-		c := byteFromBase64(s[4*i+2])
-		d := byteFromBase64(s[4*i+3])
-		if a > 63 || b > 63 || c > 63 || d > 63 {
-			return nil, ErrInvalidB64Char
-		}
-		packed := (int32(a) << 18) | (int32(b) << 12) | (int32(c) << 6) | int32(d)
-		bytes[3*i] = byte(packed >> 16)
-		bytes[3*i+1] = byte(packed >> 8)
-		bytes[3*i+2] = byte(packed)
-	}
-	return bytes[:nbytes-padding], nil // TODO returned slice will occupy padding
+	return stdBase64.decode(s)
 }
 
 func toHexString(bytes []byte) hex {
-	length := len(bytes)
-	buf := make([]byte, 2*length)
-	for i := 0; i < length; i++ {
-		a := bytes[i] >> 4
-		b := bytes[i] & 0xf
-		buf[2*i] = hexAlphabet[a]
-		buf[2*i+1] = hexAlphabet[b]
-	}
+	buf := make([]byte, 2*len(bytes))
+	encodeHex(buf, bytes)
 	return hex(buf)
 }
 
-func toBase64String(bytes []byte) base64 {
-	length := len(bytes)
-	n := length / 3
-	rem := length % 3
-	extra := 0
-	if rem > 0 {
-		extra = 4
-	}
-	buf := make([]byte, 4*n+extra)
-	for i := 0; i < n; i++ {
-		a := int32(bytes[3*i]) << 16
-		b := int32(bytes[3*i+1]) << 8
-		c := int32(bytes[3*i+2])
-		packed := a | b | c
-		buf[4*i] = base64Alphabet[(packed>>18)&0x3f]
-		buf[4*i+1] = base64Alphabet[(packed>>12)&0x3f]
-		buf[4*i+2] = base64Alphabet[(packed>>6)&0x3f]
-		buf[4*i+3] = base64Alphabet[packed&0x3f]
-	}
-	switch rem {
-	case 0:
-		break
-	case 1:
-		packed := int32(bytes[3*n]) << 16
-		buf[4*n] = base64Alphabet[(packed>>18)&0x3f]
-		buf[4*n+1] = base64Alphabet[(packed>>12)&0x3f]
-		buf[4*n+2] = '='
-		buf[4*n+3] = '='
-	case 2:
-		packed := (int32(bytes[3*n]) << 16) | (int32(bytes[3*n+1]) << 8)
-		buf[4*n] = base64Alphabet[(packed>>18)&0x3f]
-		buf[4*n+1] = base64Alphabet[(packed>>12)&0x3f]
-		buf[4*n+2] = base64Alphabet[(packed>>6)&0x3f]
-		buf[4*n+3] = '='
-	default:
-		panic("unreachable")
+// encodeHex writes the hex encoding of src to dst, which must hold at least
+// 2*len(src) bytes.
+func encodeHex(dst, src []byte) {
+	for i, x := range src {
+		dst[2*i] = hexAlphabet[x>>4]
+		dst[2*i+1] = hexAlphabet[x&0xf]
 	}
-	return base64(buf)
+}
+
+func toBase64String(bytes []byte) base64 {
+	return stdBase64.encode(bytes)
 }
 
 func xor(x, y []byte) ([]byte, error) {
+	if len(x) != len(y) {
+		return nil, ErrDiffInputLen
+	}
+	buf := make([]byte, len(x))
+	xorInto(buf, x, y)
+	return buf, nil
+}
+
+// xorInto writes x XOR y to the start of dst, which may be x or y.
+func xorInto(dst, x, y []byte) error {
 	length := len(x)
 	if length != len(y) {
-		return nil, ErrDiffInputLen
+		return ErrDiffInputLen
+	}
+	if len(dst) < length {
+		return ErrShortDst
 	}
-	buf := make([]byte, length)
 	for i := 0; i < length; i++ {
-		buf[i] = x[i] ^ y[i]
+		dst[i] = x[i] ^ y[i]
 	}
-	return buf, nil
+	return nil
+}
+
+// xorInPlace XORs y into x.
+func xorInPlace(x, y []byte) error {
+	return xorInto(x, x, y)
 }
 
 func xorHex(x, y hex) (hex, error) {
@@ -223,68 +237,281 @@ var letterFreq = map[byte]float32{
 	'z': 0.00074,
 }
 
-func byteFrequency(bytes []byte, c byte) float32 {
-	count := 0
-	for _, x := range bytes {
-		if c == x {
-			count++
-		}
-	}
-	return float32(count) / float32(len(bytes))
+func calcScore(bytes []byte) float32 {
+	return calcScoreHistogram(byteHistogram(bytes), 0)
 }
 
-func calcScore(bytes []byte) float32 {
+// letterFreqs is letterFreq as an array indexed from 'a'.
+var letterFreqs = func() [26]float32 {
+	var freqs [26]float32
+	for c, f := range letterFreq {
+		freqs[c-'a'] = f
+	}
+	return freqs
+}()
+
+// calcScoreHistogram is calcScore for the bytes counted in h, each XORed with
+// key.
+func calcScoreHistogram(h *histogram, key byte) float32 {
+	if h.n == 0 {
+		return 0
+	}
+	var letters [26]int
+	for i, count := range h.counts {
+		if c := h.values[i] ^ key; c >= 'a' && c <= 'z' {
+			letters[c-'a'] += count
+		}
+	}
 	score := float32(0)
-	for c, f1 := range letterFreq {
-		f2 := byteFrequency(bytes, c)
+	for i, f1 := range letterFreqs {
+		f2 := float32(letters[i]) / float32(h.n)
 		score += absFloat32(f2 - f1) // L1 norm
 	}
 	return score
 }
 
-func crackSingleCharXorHexs(hexs []hex) (string, error) {
-	var bestMatch string
-	var bestScore float32 = math.MaxFloat32
-	for _, x := range hexs {
+type hexLineResult struct {
+	index      int // Line in the input, counting from 0
+	key        byte
+	score      float32
+	confidence float32
+	plaintext  string
+	language   *langProfile // See xorCandidate
+}
+
+type hexLineError struct {
+	index int
+	err   error
+}
+
+type singleXorReport struct {
+	results []hexLineResult // Best first
+	errors  []hexLineError  // In input order
+}
+
+// crackSingleCharXorHexs cracks every line of hexs on its own and ranks the
+// lines by the score of their best key, keeping the n best or all of them if
+// n <= 0. Lines that aren't valid hex or are empty, which any key would
+// score perfectly, are reported separately and don't stop the others from
+// being cracked.
+func crackSingleCharXorHexs(hexs []hex, s scorer, n int) singleXorReport {
+	var report singleXorReport
+	for i, x := range hexs {
 		bytes, err := fromHexString(x)
 		if err != nil {
-			return "", err
+			report.errors = append(report.errors, hexLineError{i, err})
+			continue
 		}
-		match, score, _ := crackSingleCharXor(bytes)
-		if score < bestScore {
-			bestMatch = string(match)
-			bestScore = score
+		if len(bytes) == 0 {
+			report.errors = append(report.errors, hexLineError{i, ErrEmptyHexLine})
+			continue
 		}
+		res := crackSingleCharXorRanked(bytes, s, 1)
+		best := res.candidates[0]
+		report.results = append(report.results, hexLineResult{i, best.key, best.score, res.confidence, string(best.plaintext), best.language})
+	}
+	sort.SliceStable(report.results, func(i, j int) bool {
+		return report.results[i].score < report.results[j].score
+	})
+	if n > 0 && n < len(report.results) {
+		report.results = report.results[:n]
 	}
-	return bestMatch, nil
+	return report
+}
+
+func crackSingleCharXor(bytes []byte, s scorer) (string, float32, byte) {
+	best := crackSingleCharXorRanked(bytes, s, 1).candidates[0]
+	return string(best.plaintext), best.score, best.key
 }
 
-func crackSingleCharXor(bytes []byte) (string, float32, byte) {
-	var bestMatch string
-	var bestScore float32 = math.MaxFloat32
-	var bestKey byte
-	buf := make([]byte, len(bytes))
+type xorCandidate struct {
+	key       byte
+	score     float32
+	plaintext []byte
+	language  *langProfile // Language the scorer detected in plaintext, or nil; only set by crackSingleCharXorRanked
+}
+
+type singleXorResult struct {
+	candidates []xorCandidate // Best first
+	margin     float32        // Score of the second best minus the best
+	confidence float32        // margin relative to how far the median is from the best, from 0 to 1
+}
+
+// crackSingleCharXorRanked tries every key and keeps the n best, or all of
+// them if n is out of range. Keys that score the same are ranked in order.
+// Unlike crackSingleCharXorAllowed, which also cracks the columns of longer
+// keys, it treats the candidates as final and detects their language.
+func crackSingleCharXorRanked(bytes []byte, s scorer, n int) singleXorResult {
+	res, _ := crackSingleCharXorAllowed(bytes, s, nil, nil, n)
+	for i := range res.candidates {
+		c := &res.candidates[i]
+		c.language = detectedLanguage(s, c.plaintext)
+	}
+	return res
+}
+
+// crackSingleCharXorAllowed is crackSingleCharXorRanked for keys in keySet
+// that only turn bytes into plaintext in plainSet. A nil set allows every
+// byte. Keys that don't fit are dropped before scoring, and if none are left
+// ErrNoKeyByte is returned.
+func crackSingleCharXorAllowed(bytes []byte, s scorer, keySet, plainSet *byteSet, n int) (singleXorResult, error) {
+	h := byteHistogram(bytes)
+	var keys []int
 	for i := 0; i < 256; i++ {
-		for j := range buf {
-			buf[j] = bytes[j] ^ byte(i)
+		if keySet.allows(byte(i)) && plainSet.allowsXored(h.values, byte(i)) {
+			keys = append(keys, i)
+		}
+	}
+	if len(keys) == 0 {
+		return singleXorResult{}, ErrNoKeyByte
+	}
+	if n <= 0 || n > len(keys) {
+		n = len(keys)
+	}
+	scores := make([]float32, 256)
+	if hs, ok := s.(histogramScorer); ok {
+		for _, key := range keys {
+			scores[key] = hs.scoreHistogram(h, byte(key))
+		}
+	} else {
+		buf := make([]byte, len(bytes))
+		for _, key := range keys {
+			for j := range buf {
+				buf[j] = bytes[j] ^ byte(key)
+			}
+			scores[key] = s.score(buf)
+		}
+	}
+	if len(keys) == 1 {
+		// Nothing else fits, so there is no doubt left.
+		return singleXorResult{candidates: xorCandidates(bytes, keys, scores), confidence: 1}, nil
+	}
+	var ranked []int // Best first, at least the two best
+	var median float32
+	if n < maxScanCandidates {
+		ranked = bestKeys(keys, scores, max(n, 2))
+		median = medianScore(keys, scores)
+	} else {
+		sort.SliceStable(keys, func(i, j int) bool {
+			return scores[keys[i]] < scores[keys[j]]
+		})
+		ranked = keys
+		median = scores[keys[len(keys)/2]]
+	}
+	res := singleXorResult{candidates: xorCandidates(bytes, ranked[:n], scores)}
+	best, second := scores[ranked[0]], scores[ranked[1]]
+	res.margin = second - best
+	if median > best {
+		res.confidence = res.margin / (median - best)
+	}
+	if res.confidence > 1 {
+		res.confidence = 1
+	}
+	return res, nil
+}
+
+// maxScanCandidates is how many candidates crackSingleCharXorAllowed picks
+// with a scan over the keys before it sorts them all instead.
+const maxScanCandidates = 4
+
+// bestKeys returns the n keys that score lowest, best first. Keys that score
+// the same stay in order.
+func bestKeys(keys []int, scores []float32, n int) []int {
+	best := make([]int, 0, n)
+	for _, key := range keys {
+		i := len(best)
+		for i > 0 && scores[key] < scores[best[i-1]] {
+			i--
+		}
+		if i == n {
+			continue
 		}
-		score := calcScore(buf)
-		if score < bestScore {
-			bestMatch = string(buf)
-			bestScore = score
-			bestKey = byte(i)
+		if len(best) < n {
+			best = append(best, 0)
 		}
+		// Shift the worse keys down, dropping the last one if best is full.
+		copy(best[i+1:], best[i:])
+		best[i] = key
 	}
-	return bestMatch, bestScore, bestKey
+	return best
 }
 
-func repeatingKeyXor(input, key []byte) []byte {
-	keyLen := len(key)
+// medianScore is the score that keys would have at len(keys)/2 if they were
+// sorted, found by quickselect.
+func medianScore(keys []int, scores []float32) float32 {
+	a := make([]float32, len(keys))
+	for i, key := range keys {
+		a[i] = scores[key]
+	}
+	k, lo, hi := len(a)/2, 0, len(a)-1
+	for lo < hi {
+		pivot := a[(lo+hi)/2]
+		i, j := lo, hi
+		for i <= j {
+			for a[i] < pivot {
+				i++
+			}
+			for a[j] > pivot {
+				j--
+			}
+			if i <= j {
+				a[i], a[j] = a[j], a[i]
+				i++
+				j--
+			}
+		}
+		switch {
+		case k <= j:
+			hi = j
+		case k >= i:
+			lo = i
+		default:
+			return a[k]
+		}
+	}
+	return a[k]
+}
+
+// xorCandidates decrypts bytes with each of keys.
+func xorCandidates(bytes []byte, keys []int, scores []float32) []xorCandidate {
+	candidates := make([]xorCandidate, len(keys))
+	for i, key := range keys {
+		plaintext := make([]byte, len(bytes))
+		for j, c := range bytes {
+			plaintext[j] = c ^ byte(key)
+		}
+		candidates[i] = xorCandidate{key: byte(key), score: scores[key], plaintext: plaintext}
+	}
+	return candidates
+}
+
+func repeatingKeyXor(input, key []byte) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, ErrEmptyKey
+	}
 	buf := make([]byte, len(input))
+	repeatingKeyXorInto(buf, input, key)
+	return buf, nil
+}
+
+// repeatingKeyXorInto writes input XOR key, repeated as needed, to the start
+// of dst, which may be input.
+func repeatingKeyXorInto(dst, input, key []byte) error {
+	keyLen := len(key)
+	if keyLen == 0 {
+		return ErrEmptyKey
+	}
+	if len(dst) < len(input) {
+		return ErrShortDst
+	}
 	for i, c := range input {
-		buf[i] = c ^ key[i%keyLen]
+		dst[i] = c ^ key[i%keyLen]
 	}
-	return buf
+	return nil
+}
+
+func repeatingKeyXorInPlace(buf, key []byte) error {
+	return repeatingKeyXorInto(buf, buf, key)
 }
 
 func countOnes(bytes []byte) int {
@@ -298,77 +525,182 @@ func countOnes(bytes []byte) int {
 }
 
 func hammingDistance(x, y []byte) (int, error) {
-	xored, err := xor(x, y)
+	if len(x) != len(y) {
+		return -1, ErrDiffInputLen
+	}
+	return hammingDistanceOverlap(x, y), nil
+}
+
+// hammingDistanceOverlap compares x and y over the length of the shorter.
+func hammingDistanceOverlap(x, y []byte) int {
+	n := min(len(x), len(y))
+	count := 0
+	for i := 0; i < n; i++ {
+		count += bits.OnesCount8(x[i] ^ y[i])
+	}
+	return count
+}
+
+// hammingDistancePadded compares x and y as if the shorter were padded with
+// zero bytes, so every set bit past its end counts.
+func hammingDistancePadded(x, y []byte) int {
+	if len(x) < len(y) {
+		x, y = y, x
+	}
+	return hammingDistanceOverlap(x, y) + countOnes(x[len(y):])
+}
+
+type keyCandidate struct {
+	keySize   int
+	sizeScore float32 // Score of keySize from the estimator
+	key       []byte
+}
+
+// findRepeatingKeyXorCandidates cracks a key for each of the key sizes that
+// the estimator in opts likes best, best first. Key sizes for which the byte
+// sets in opts leave a column without a key byte are skipped, and the error
+// for the first of them is returned if that leaves none.
+func findRepeatingKeyXorCandidates(ctx context.Context, input []byte, s scorer, opts xorOptions) ([]keyCandidate, error) {
+	opts = opts.withDefaults()
+	sizes, err := estimateKeySizes(ctx, input, opts)
 	if err != nil {
-		return -1, err
-	}
-	return countOnes(xored), nil
-}
-
-func findRepeatingKeyXorCandidates(input []byte) [][]byte {
-	type info struct {
-		keySize int
-		norm    float32
-	}
-	blocks := 4 // Number of blocks to average distance between
-	norms := make([]info, 0)
-	for keySize := 2; keySize < 40; keySize++ {
-		var dist int
-		for i := 0; i < blocks; i++ {
-			slice1 := input[i*keySize : (i+1)*keySize]
-			slice2 := input[(i+1)*keySize : (i+2)*keySize]
-			hamming, err := hammingDistance(slice1, slice2)
-			if err != nil {
-				panic(err) // TODO
+		return nil, err
+	}
+	if len(sizes) > opts.candidates {
+		sizes = sizes[:opts.candidates]
+	}
+	var candidates []keyCandidate
+	var firstErr error
+	for _, c := range sizes {
+		key, err := crackKeyAssumingKeySize(ctx, input, c.keySize, s, opts)
+		switch {
+		case errors.Is(err, ErrNoKeyByte):
+			// The byte sets rule out this key size.
+			if firstErr == nil {
+				firstErr = err
 			}
-			dist += hamming
+			continue
+		case err != nil:
+			return nil, err
 		}
-		norms = append(norms, info{keySize, float32(dist) / float32(blocks*keySize)})
+		candidates = append(candidates, keyCandidate{c.keySize, c.score, key})
+	}
+	if len(candidates) == 0 {
+		return nil, firstErr
+	}
+	return candidates, nil
+}
+
+// crackKeyAssumingKeySize cracks each column of bytes encrypted with the same
+// key byte in parallel, within the byte sets in opts.
+func crackKeyAssumingKeySize(ctx context.Context, input []byte, keySize int, s scorer, opts xorOptions) ([]byte, error) {
+	if keySize < 1 {
+		return nil, ErrInvalidKeySize
 	}
-	sort.Slice(norms, func(i, j int) bool {
-		return norms[i].norm < norms[j].norm
+	transpose := transposeColumns(input, keySize)
+	key := make([]byte, keySize)
+	errs := make([]error, keySize)
+	err := parallelFor(ctx, keySize, opts.workers, func(i int) {
+		res, err := crackSingleCharXorAllowed(transpose[i], s, opts.keyBytes, opts.plaintextBytes, 1)
+		if err != nil {
+			errs[i] = &keyColumnError{keySize, i, err}
+			return
+		}
+		key[i] = res.candidates[0].key
 	})
-	n := 3 // Number of candidates
-	candidates := make([][]byte, n)
-	for i, c := range norms[:n] {
-		candidates[i] = crackKeyAssumingKeySize(input, c.keySize)
+	if err != nil {
+		return nil, err
 	}
-	return candidates
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
 }
 
-func crackKeyAssumingKeySize(input []byte, keySize int) []byte {
-	length := len(input)
-	chunks := length / keySize
-	// TODO Ignoring the remainder at the end. Maybe irrelevant anyway?
+// transposeColumns splits input into the columns of bytes that are encrypted
+// with the same byte of a key of keySize bytes.
+func transposeColumns(input []byte, keySize int) [][]byte {
+	// Columns before the end of the last, partial, chunk get one byte more.
+	chunks, rest := len(input)/keySize, len(input)%keySize
 	transpose := make([][]byte, keySize)
 	for i := 0; i < keySize; i++ {
-		transpose[i] = make([]byte, chunks)
-		for j := 0; j < chunks; j++ {
+		n := chunks
+		if i < rest {
+			n++
+		}
+		transpose[i] = make([]byte, n)
+		for j := 0; j < n; j++ {
 			transpose[i][j] = input[j*keySize+i]
 		}
 	}
-	key := make([]byte, keySize)
-	for i, chunk := range transpose {
-		_, _, char := crackSingleCharXor(chunk)
-		key[i] = char
+	return transpose
+}
+
+type crackedKey struct {
+	key       []byte
+	score     float32 // Score of the whole plaintext
+	plaintext []byte
+	language  *langProfile // See xorCandidate
+}
+
+type repeatingXorResult struct {
+	crackedKey              // The best key
+	confidence float32      // Score of the second best key relative to the best, from 0 to 1
+	ranked     []crackedKey // Every distinct key found, best first
+}
+
+// crackRepeatingKeyXor finds keys for the most likely key sizes, decrypts the
+// whole input with each and picks the key whose plaintext scores best. Keys
+// that repeat a shorter key are cut down to it and counted once.
+func crackRepeatingKeyXor(ctx context.Context, input []byte, s scorer, opts xorOptions) (repeatingXorResult, error) {
+	candidates, err := findRepeatingKeyXorCandidates(ctx, input, s, opts)
+	if err != nil {
+		return repeatingXorResult{}, err
 	}
-	return key
+	var res repeatingXorResult
+	seen := make(map[string]bool)
+	for _, c := range candidates {
+		key := keyPeriod(c.key)
+		if seen[string(key)] {
+			continue
+		}
+		seen[string(key)] = true
+		plaintext, _ := repeatingKeyXor(input, key)
+		res.ranked = append(res.ranked, crackedKey{key, s.score(plaintext), plaintext, detectedLanguage(s, plaintext)})
+	}
+	sort.SliceStable(res.ranked, func(i, j int) bool {
+		return res.ranked[i].score < res.ranked[j].score
+	})
+	res.crackedKey = res.ranked[0]
+	res.confidence = 1
+	if len(res.ranked) > 1 {
+		second := res.ranked[1].score
+		res.confidence = 0
+		if second > 0 && second > res.score {
+			res.confidence = (second - res.score) / second
+		}
+	}
+	return res, nil
 }
 
-func readBase64File(filename string) ([]byte, error) {
-	file, err := os.Open(filename)
+// crackRepeatingKeyXorFile loads filename in whatever format it is in and
+// cracks it.
+func crackRepeatingKeyXorFile(ctx context.Context, filename string, s scorer, opts xorOptions) (repeatingXorResult, error) {
+	input, _, err := loadFile(filename)
 	if err != nil {
-		return nil, err
+		return repeatingXorResult{}, err
 	}
-	defer file.Close()
-	scanner := bufio.NewScanner(file)
-	var input []byte
-	for scanner.Scan() {
-		bytes, err := fromBase64String(base64(scanner.Text()))
-		if err != nil {
-			return nil, err
+	return crackRepeatingKeyXor(ctx, input, s, opts)
+}
+
+// keyPeriod returns the shortest prefix of key that key is a repetition of.
+func keyPeriod(key []byte) []byte {
+	for p := 1; p < len(key); p++ {
+		if len(key)%p == 0 && bytes.Equal(key[p:], key[:len(key)-p]) {
+			return key[:p]
 		}
-		input = append(input, bytes...)
 	}
-	return input, nil
+	return key
 }
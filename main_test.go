@@ -3,10 +3,13 @@ package main
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"cryptopals/aes"
+	"errors"
 	"io/ioutil"
 	"math/rand"
 	"os"
+	"reflect"
 	"testing"
 )
 
@@ -74,7 +77,7 @@ func TestBase64FromHex(t *testing.T) {
 	for _, test := range tests {
 		input, want, wanterr := test.input, test.want, test.wanterr
 		got, err := base64FromHex(input)
-		if wanterr != err {
+		if !errors.Is(err, wanterr) {
 			t.Errorf("base64FromHex(%q) = '%v', want '%v'\n", input, err, wanterr)
 		} else if got != want {
 			t.Errorf("base64FromHex(%q) = '%v', want '%v'\n", input, got, want)
@@ -99,7 +102,7 @@ func TestHexFromBase64(t *testing.T) {
 	for _, test := range tests {
 		input, want, wanterr := test.input, test.want, test.wanterr
 		got, err := hexFromBase64(input)
-		if err != wanterr {
+		if !errors.Is(err, wanterr) {
 			t.Errorf("hexFromBase64(%q) = '%v', want '%v'\n", input, err, wanterr)
 		} else if got != want {
 			t.Errorf("hexFromBase64(%q) = '%v', want '%v'\n", input, got, want)
@@ -107,6 +110,70 @@ func TestHexFromBase64(t *testing.T) {
 	}
 }
 
+func TestDecodeErrorPosition(t *testing.T) {
+	tests := []struct {
+		input        string
+		decode       func(string) error
+		wanterr      error
+		offset, line int
+		char         byte
+	}{
+		{"4d6x", decodeHex, ErrInvalidHexChar, 3, 0, 'x'},
+		{"4d6", decodeHex, ErrInvalidHexLen, 3, 0, 0},
+		{"TW=u", decodeBase64, ErrInvalidB64Pad, 2, 0, '='},
+		{"TWFu\nTW\tu\n", decodeBase64File, ErrInvalidB64Char, 2, 2, '\t'},
+	}
+	for _, test := range tests {
+		err := test.decode(test.input)
+		var derr *decodeError
+		if !errors.Is(err, test.wanterr) || !errors.As(err, &derr) {
+			t.Errorf("decoding %q = '%v', want '%v'", test.input, err, test.wanterr)
+			continue
+		}
+		if derr.offset != int64(test.offset) || derr.line != test.line || derr.char != test.char {
+			t.Errorf("decoding %q = '%v', want offset %d, line %d, char %q",
+				test.input, err, test.offset, test.line, test.char)
+		}
+	}
+}
+
+func TestDecodeErrorMessage(t *testing.T) {
+	_, err := parseHexdump("00000000: 4d61 6e20\n00000004: 6973 2g64\n")
+	want := `hexdump: fromHexString: invalid input char 'g' at offset 16 on line 2`
+	if err == nil || err.Error() != want {
+		t.Errorf("got '%v', want '%v'", err, want)
+	}
+	_, err = fromHexString("4d6")
+	want = `hex: fromHexString: invalid input length at offset 3`
+	if err == nil || err.Error() != want {
+		t.Errorf("got '%v', want '%v'", err, want)
+	}
+}
+
+func decodeHex(s string) error {
+	_, err := fromHexString(hex(s))
+	return err
+}
+
+func decodeBase64(s string) error {
+	_, err := fromBase64String(base64(s))
+	return err
+}
+
+func decodeBase64File(s string) error {
+	file, err := ioutil.TempFile("", "cryptopals")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+	defer file.Close()
+	if _, err := file.WriteString(s); err != nil {
+		return err
+	}
+	_, err = loadFileAs(file.Name(), inputBase64)
+	return err
+}
+
 func TestXorHex(t *testing.T) {
 	tests := []struct {
 		x1, x2  hex
@@ -131,7 +198,7 @@ func TestXorHex(t *testing.T) {
 	for _, test := range tests {
 		x1, x2, want, wanterr := test.x1, test.x2, test.want, test.wanterr
 		got, err := xorHex(x1, x2)
-		if err != wanterr {
+		if !errors.Is(err, wanterr) {
 			t.Errorf("xorHex(%s, %s) = '%v', want '%v'\n", x1, x2, err, wanterr)
 		} else if got != want {
 			t.Errorf("xorHex(%s, %s) = '%v', want '%v'\n", x1, x2, got, want)
@@ -139,18 +206,92 @@ func TestXorHex(t *testing.T) {
 	}
 }
 
+// bestHexLine returns the plaintext of the best line, or the first error.
+func bestHexLine(hexs []hex, s scorer) (string, error) {
+	report := crackSingleCharXorHexs(hexs, s, 1)
+	if len(report.errors) > 0 {
+		return "", report.errors[0].err
+	}
+	return report.results[0].plaintext, nil
+}
+
 func TestChallenge1_3(t *testing.T) {
 	input := []hex{"1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736"}
-	got, err := crackSingleCharXorHexs(input)
+	got, err := bestHexLine(input, l1Scorer{})
 	if err != nil || got != "Cooking MC's like a pound of bacon" {
 		t.Error()
 	}
 }
 
-func TestChallenge1_4(t *testing.T) {
-	file, err := os.Open("data/4.txt")
+func TestCrackSingleCharXorRanked(t *testing.T) {
+	input, _ := fromHexString("1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736")
+	res := crackSingleCharXorRanked(input, l1Scorer{}, 5)
+	if len(res.candidates) != 5 {
+		t.Fatalf("got %d candidates, want 5", len(res.candidates))
+	}
+	best := res.candidates[0]
+	if best.key != 'X' || string(best.plaintext) != "Cooking MC's like a pound of bacon" {
+		t.Errorf("best = %#02x %q", best.key, best.plaintext)
+	}
+	for i := 1; i < len(res.candidates); i++ {
+		if res.candidates[i].score < res.candidates[i-1].score {
+			t.Errorf("candidate %d scores better than %d", i, i-1)
+		}
+	}
+	if want := res.candidates[1].score - best.score; res.margin != want {
+		t.Errorf("margin = %v, want %v", res.margin, want)
+	}
+	if res.confidence <= 0 || res.confidence > 1 {
+		t.Errorf("confidence = %v", res.confidence)
+	}
+	if all := crackSingleCharXorRanked(input, l1Scorer{}, 0); len(all.candidates) != 256 {
+		t.Errorf("n = 0 kept %d candidates, want 256", len(all.candidates))
+	}
+	// printableScorer gives all printable plaintexts the same score, so ties
+	// must rank by key.
+	flat := crackSingleCharXorRanked([]byte{0, 0, 0}, printableScorer{}, 256)
+	for i := 1; i < len(flat.candidates); i++ {
+		x, y := flat.candidates[i-1], flat.candidates[i]
+		if x.score == y.score && x.key > y.key {
+			t.Errorf("tied keys %#02x and %#02x out of order", x.key, y.key)
+		}
+	}
+	if flat.confidence != 0 {
+		t.Errorf("confidence of a tie = %v, want 0", flat.confidence)
+	}
+}
+
+func TestCrackSingleCharXorRankedScan(t *testing.T) {
+	// Small n picks keys with a scan rather than a sort, which must rank the
+	// same, ties included.
+	rng := rand.New(rand.NewSource(1))
+	inputs := [][]byte{{0, 0, 0}}
+	for i := 0; i < 20; i++ {
+		input := make([]byte, 1+rng.Intn(60))
+		rng.Read(input)
+		inputs = append(inputs, input)
+	}
+	for _, s := range []scorer{l1Scorer{}, printableScorer{}} {
+		for _, input := range inputs {
+			all := crackSingleCharXorRanked(input, s, 0)
+			for n := 1; n < maxScanCandidates; n++ {
+				res := crackSingleCharXorRanked(input, s, n)
+				if !reflect.DeepEqual(res.candidates, all.candidates[:n]) {
+					t.Errorf("%T %x: n = %d ranks differently from a sort", s, input, n)
+				}
+				if res.margin != all.margin || res.confidence != all.confidence {
+					t.Errorf("%T %x: n = %d got margin %v, confidence %v, want %v, %v",
+						s, input, n, res.margin, res.confidence, all.margin, all.confidence)
+				}
+			}
+		}
+	}
+}
+
+func readHexLines(filename string) ([]hex, error) {
+	file, err := os.Open(filename)
 	if err != nil {
-		t.Fatal(err)
+		return nil, err
 	}
 	defer file.Close()
 	scanner := bufio.NewScanner(file)
@@ -158,20 +299,91 @@ func TestChallenge1_4(t *testing.T) {
 	for scanner.Scan() {
 		hexs = append(hexs, hex(scanner.Text()))
 	}
-	got, err := crackSingleCharXorHexs(hexs)
+	return hexs, scanner.Err()
+}
+
+func TestChallenge1_4(t *testing.T) {
+	hexs, err := readHexLines("data/4.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := bestHexLine(hexs, l1Scorer{})
 	if err != nil || got != "Now that the party is jumping\n" {
 		t.Error("Challenge 1.4 failed")
 	}
 }
 
+func TestCrackSingleCharXorHexsReport(t *testing.T) {
+	hexs, err := readHexLines("data/4.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hexs = append([]hex{"7", "zz", ""}, hexs...)
+	report := crackSingleCharXorHexs(hexs, l1Scorer{}, 3)
+	if len(report.results) != 3 {
+		t.Fatalf("got %d results, want 3", len(report.results))
+	}
+	best := report.results[0]
+	if best.index != 173 || best.key != 0x35 || best.plaintext != "Now that the party is jumping\n" {
+		t.Errorf("best = %+v", best)
+	}
+	for i := 1; i < len(report.results); i++ {
+		if report.results[i].score < report.results[i-1].score {
+			t.Errorf("result %d scores better than %d", i, i-1)
+		}
+	}
+	if len(report.errors) != 3 ||
+		report.errors[0].index != 0 || !errors.Is(report.errors[0].err, ErrInvalidHexLen) ||
+		report.errors[1].index != 1 || !errors.Is(report.errors[1].err, ErrInvalidHexChar) ||
+		report.errors[2].index != 2 || report.errors[2].err != ErrEmptyHexLine {
+		t.Errorf("errors = %v", report.errors)
+	}
+	if all := crackSingleCharXorHexs(hexs, l1Scorer{}, 0); len(all.results) != len(hexs)-3 {
+		t.Errorf("n = 0 kept %d results, want %d", len(all.results), len(hexs)-3)
+	}
+}
+
 func TestChallenge1_5(t *testing.T) {
 	key := []byte("ICE")
 	input := []byte("Burning 'em, if you ain't quick and nimble\nI go crazy when I hear a cymbal")
 	want := hex("0b3637272a2b2e63622c2e69692a23693a2a3c6324202d623d63343c2a26226324272765272a282b2f20430a652e2c652a3124333a653e2b2027630c692b20283165286326302e27282f")
-	got := toHexString(repeatingKeyXor(input, key))
-	if got != want {
+	encrypted, err := repeatingKeyXor(input, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := toHexString(encrypted); got != want {
 		t.Error("Challenge 1.5 failed")
 	}
+	buf := append([]byte(nil), input...)
+	if err := repeatingKeyXorInPlace(buf, key); err != nil || toHexString(buf) != want {
+		t.Errorf("in place: got %s, '%v'", toHexString(buf), err)
+	}
+}
+
+func TestRepeatingKeyXorInvalid(t *testing.T) {
+	if _, err := repeatingKeyXor([]byte("input"), nil); err != ErrEmptyKey {
+		t.Errorf("got '%v', want '%v'", err, ErrEmptyKey)
+	}
+	if err := repeatingKeyXorInto(make([]byte, 4), []byte("input"), []byte("k")); err != ErrShortDst {
+		t.Errorf("got '%v', want '%v'", err, ErrShortDst)
+	}
+}
+
+func TestXorInto(t *testing.T) {
+	x, y := []byte{0x0f, 0xf0, 0xaa}, []byte{0xff, 0xff, 0x0f}
+	dst := make([]byte, 4)
+	if err := xorInto(dst, x, y); err != nil || !bytes.Equal(dst, []byte{0xf0, 0x0f, 0xa5, 0x00}) {
+		t.Errorf("got %x, '%v'", dst, err)
+	}
+	if err := xorInPlace(x, y); err != nil || !bytes.Equal(x, []byte{0xf0, 0x0f, 0xa5}) {
+		t.Errorf("in place: got %x, '%v'", x, err)
+	}
+	if err := xorInto(dst, x, y[:2]); err != ErrDiffInputLen {
+		t.Errorf("got '%v', want '%v'", err, ErrDiffInputLen)
+	}
+	if err := xorInto(dst[:2], x, y); err != ErrShortDst {
+		t.Errorf("got '%v', want '%v'", err, ErrShortDst)
+	}
 }
 
 func TestHammingDistance(t *testing.T) {
@@ -181,34 +393,103 @@ func TestHammingDistance(t *testing.T) {
 	if err != nil || dist != 37 {
 		t.Error()
 	}
+	if _, err := hammingDistance(x, y[:3]); err != ErrDiffInputLen {
+		t.Errorf("got '%v', want '%v'", err, ErrDiffInputLen)
+	}
+	longer := append(append([]byte(nil), y...), 0x00, 0x07)
+	if got := hammingDistanceOverlap(x, longer); got != 37 {
+		t.Errorf("overlap: got %d, want 37", got)
+	}
+	if got := hammingDistancePadded(longer, x); got != 40 {
+		t.Errorf("padded: got %d, want 40", got)
+	}
+}
+
+func TestTransposeColumns(t *testing.T) {
+	got := transposeColumns([]byte("abcdefgh"), 3)
+	want := [][]byte{[]byte("adg"), []byte("beh"), []byte("cf")}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
+
+func TestCrackKeyInvalidKeySize(t *testing.T) {
+	input := []byte("some ciphertext")
+	for _, keySize := range []int{0, -1} {
+		if _, err := crackKeyAssumingKeySize(context.Background(), input, keySize, l1Scorer{}, xorOptions{}); err != ErrInvalidKeySize {
+			t.Errorf("crackKeyAssumingKeySize(%d): got '%v', want '%v'", keySize, err, ErrInvalidKeySize)
+		}
+		if _, err := crackKeyBeamSearch(context.Background(), input, keySize, l1Scorer{}, nil, xorOptions{}); err != ErrInvalidKeySize {
+			t.Errorf("crackKeyBeamSearch(%d): got '%v', want '%v'", keySize, err, ErrInvalidKeySize)
+		}
+	}
 }
 
 func TestChallenge1_6(t *testing.T) {
-	input, err := readBase64File("data/6.txt")
+	res, err := crackRepeatingKeyXorFile(context.Background(), "data/6.txt", l1Scorer{}, xorOptions{})
 	if err != nil {
 		t.Fatal(err)
 	}
-	keys := findRepeatingKeyXorCandidates(input)
-	results := make([][]byte, len(keys))
-	for i, key := range keys {
-		results[i] = repeatingKeyXor(input, key)
-	}
-	// Introspected data through debugger to find second entry
-	key, got := keys[2], results[2]
 	wantkey := []byte("Terminator X: Bring the noise")
 	want, err := ioutil.ReadFile("data/want1_6.txt")
 	if err != nil {
 		t.Fatal(err)
 	}
-	if !bytes.Equal(got, want) || !bytes.Equal(key, wantkey) {
+	if !bytes.Equal(res.plaintext, want) || !bytes.Equal(res.key, wantkey) {
 		t.Error("Challenge 1.6 failed")
 	}
+	if len(res.ranked) < 2 || !bytes.Equal(res.ranked[0].key, wantkey) || res.confidence <= 0 {
+		t.Errorf("ranked = %d keys, confidence = %v", len(res.ranked), res.confidence)
+	}
 }
 
-func TestChallenge1_7(t *testing.T) {
-	input, err := readBase64File("data/7.txt")
+func TestCrackRepeatingKeyXorPeriod(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
 	if err != nil {
-		panic(err)
+		t.Fatal(err)
+	}
+	input, _ := repeatingKeyXor(corpus, []byte("ICE"))
+	res, err := crackRepeatingKeyXor(context.Background(), input, logLikelihoodScorer{}, xorOptions{candidates: 10})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(res.key) != "ICE" || !bytes.Equal(res.plaintext, corpus) {
+		t.Errorf("key = %q", res.key)
+	}
+	seen := make(map[string]bool)
+	for _, k := range res.ranked {
+		if seen[string(k.key)] {
+			t.Errorf("key %q ranked twice", k.key)
+		}
+		seen[string(k.key)] = true
+	}
+	if _, err := crackRepeatingKeyXor(context.Background(), input[:3], l1Scorer{}, xorOptions{}); !errors.Is(err, ErrInputTooShort) {
+		t.Errorf("got '%v', want '%v'", err, ErrInputTooShort)
+	}
+}
+
+func TestKeyPeriod(t *testing.T) {
+	for _, test := range []struct {
+		key, want string
+	}{
+		{"", ""},
+		{"a", "a"},
+		{"aaaa", "a"},
+		{"abab", "ab"},
+		{"ababa", "ababa"},
+		{"ICEICEICE", "ICE"},
+		{"ICEICEICF", "ICEICEICF"},
+	} {
+		if got := keyPeriod([]byte(test.key)); string(got) != test.want {
+			t.Errorf("keyPeriod(%q) = %q, want %q", test.key, got, test.want)
+		}
+	}
+}
+
+func TestChallenge1_7(t *testing.T) {
+	input, format, err := loadFile("data/7.txt")
+	if err != nil || format != inputBase64 {
+		t.Fatal(format, err)
 	}
 
 	key := []byte("YELLOW SUBMARINE")
@@ -0,0 +1,94 @@
+package main
+
+import "errors"
+
+// plaintextFix says that the plaintext of a message is known at an offset.
+// That gives the keystream byte there, and so the plaintext of every other
+// message at the same offset.
+type plaintextFix struct {
+	message, offset int
+	plaintext       byte
+}
+
+var (
+	ErrInvalidFix     = errors.New("crackManyTimePad: fix outside of the messages")
+	ErrConflictingFix = errors.New("crackManyTimePad: fixes disagree on the keystream")
+)
+
+type manyTimePadResult struct {
+	keystream  []byte
+	plaintexts [][]byte
+	coverage   []int // Number of messages long enough to reach each offset
+}
+
+// plaintextFixes makes a fix for every byte of text, as the plaintext of
+// message from offset on.
+func plaintextFixes(message, offset int, text string) []plaintextFix {
+	fixes := make([]plaintextFix, len(text))
+	for i := range fixes {
+		fixes[i] = plaintextFix{message, offset + i, text[i]}
+	}
+	return fixes
+}
+
+// crackManyTimePad recovers a keystream that was used for every one of
+// ciphertexts. Each offset is cracked as single-byte XOR of the bytes the
+// ciphertexts have there, so the guesses get worse towards the end, where few
+// messages are left. fixes then override the guesses.
+func crackManyTimePad(ciphertexts [][]byte, s scorer, fixes []plaintextFix) (manyTimePadResult, error) {
+	var res manyTimePadResult
+	for _, c := range ciphertexts {
+		for len(res.coverage) < len(c) {
+			res.coverage = append(res.coverage, 0)
+		}
+		for i := range c {
+			res.coverage[i]++
+		}
+	}
+	res.keystream = make([]byte, len(res.coverage))
+	column := make([]byte, 0, len(ciphertexts))
+	for i := range res.keystream {
+		column = column[:0]
+		for _, c := range ciphertexts {
+			if i < len(c) {
+				column = append(column, c[i])
+			}
+		}
+		_, _, res.keystream[i] = crackSingleCharXor(column, s)
+	}
+	if err := applyPlaintextFixes(res.keystream, ciphertexts, fixes); err != nil {
+		return manyTimePadResult{}, err
+	}
+	res.plaintexts = decryptManyTimePad(res.keystream, ciphertexts)
+	return res, nil
+}
+
+// applyPlaintextFixes sets the keystream bytes that fixes give. If any fix is
+// invalid keystream is left as it was.
+func applyPlaintextFixes(keystream []byte, ciphertexts [][]byte, fixes []plaintextFix) error {
+	fixed := make(map[int]byte)
+	for _, f := range fixes {
+		if f.message < 0 || f.message >= len(ciphertexts) || f.offset < 0 || f.offset >= len(ciphertexts[f.message]) {
+			return ErrInvalidFix
+		}
+		k := ciphertexts[f.message][f.offset] ^ f.plaintext
+		if prev, ok := fixed[f.offset]; ok && prev != k {
+			return ErrConflictingFix
+		}
+		fixed[f.offset] = k
+	}
+	for offset, k := range fixed {
+		keystream[offset] = k
+	}
+	return nil
+}
+
+// decryptManyTimePad XORs each ciphertext with as much of keystream as it
+// needs. The keystream must be as long as the longest ciphertext.
+func decryptManyTimePad(keystream []byte, ciphertexts [][]byte) [][]byte {
+	plaintexts := make([][]byte, len(ciphertexts))
+	for i, c := range ciphertexts {
+		plaintexts[i], _ = xor(c, keystream[:len(c)])
+	}
+	return plaintexts
+}
@@ -0,0 +1,103 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"math/rand"
+	"testing"
+)
+
+var manyTimePadMessages = []string{
+	"It was the best of times, it was the worst of times.",
+	"Call me Ishmael. Some years ago, never mind how long precisely.",
+	"All happy families are alike; each unhappy family is unhappy in its own way.",
+	"It is a truth universally acknowledged, that a single man must be in want of a wife.",
+	"Marley was dead: to begin with.",
+	"Alice was beginning to get very tired of sitting by her sister on the bank.",
+	"To Sherlock Holmes she is always the woman.",
+	"In my younger and more vulnerable years my father gave me some advice.",
+	"You will rejoice to hear that no disaster has accompanied the commencement of an enterprise.",
+	"Four score and seven years ago our fathers brought forth on this continent a new nation.",
+	"The sun shone, having no alternative, on the nothing new.",
+	"We hold these truths to be self-evident, that all men are created equal.",
+	"Happy families are all alike, and everything was in confusion in the house.",
+	"There now is your insular city of the Manhattoes, belted round by wharves.",
+	"With malice toward none, with charity for all, with firmness in the right.",
+}
+
+func encryptManyTimePad(messages []string, seed int64) ([][]byte, []byte) {
+	longest := 0
+	for _, m := range messages {
+		if len(m) > longest {
+			longest = len(m)
+		}
+	}
+	keystream := make([]byte, longest)
+	rand.New(rand.NewSource(seed)).Read(keystream)
+	ciphertexts := make([][]byte, len(messages))
+	for i, m := range messages {
+		ciphertexts[i], _ = xor([]byte(m), keystream[:len(m)])
+	}
+	return ciphertexts, keystream
+}
+
+func TestCrackManyTimePad(t *testing.T) {
+	ciphertexts, keystream := encryptManyTimePad(manyTimePadMessages, 1)
+	res, err := crackManyTimePad(ciphertexts, logLikelihoodScorer{}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.keystream) != len(keystream) || len(res.plaintexts) != len(ciphertexts) {
+		t.Fatalf("got %d keystream bytes and %d plaintexts", len(res.keystream), len(res.plaintexts))
+	}
+	// Where most messages are still going the keystream should be right.
+	wrong := 0
+	for i, k := range keystream {
+		if res.coverage[i] >= 10 && res.keystream[i] != k {
+			wrong++
+		}
+	}
+	if wrong > 2 {
+		t.Errorf("%d keystream bytes wrong where 10 or more messages overlap", wrong)
+	}
+	for i, p := range res.plaintexts {
+		if len(p) != len(manyTimePadMessages[i]) {
+			t.Errorf("plaintext %d has length %d, want %d", i, len(p), len(manyTimePadMessages[i]))
+		}
+	}
+	if res.coverage[0] != len(ciphertexts) || res.coverage[len(res.coverage)-1] != 1 {
+		t.Errorf("coverage = %v", res.coverage)
+	}
+}
+
+func TestCrackManyTimePadFixes(t *testing.T) {
+	ciphertexts, keystream := encryptManyTimePad(manyTimePadMessages, 2)
+	longest := 8 // "You will rejoice..."
+	fixes := plaintextFixes(longest, 0, manyTimePadMessages[longest])
+	res, err := crackManyTimePad(ciphertexts, logLikelihoodScorer{}, fixes)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(res.keystream, keystream) {
+		t.Errorf("keystream not recovered")
+	}
+	for i, p := range res.plaintexts {
+		if string(p) != manyTimePadMessages[i] {
+			t.Errorf("plaintext %d = %q", i, p)
+		}
+	}
+	for _, test := range []struct {
+		fixes []plaintextFix
+		err   error
+	}{
+		{[]plaintextFix{{-1, 0, 'a'}}, ErrInvalidFix},
+		{[]plaintextFix{{len(ciphertexts), 0, 'a'}}, ErrInvalidFix},
+		{[]plaintextFix{{4, len(manyTimePadMessages[4]), 'a'}}, ErrInvalidFix},
+		{[]plaintextFix{{0, 3, 'a'}, {1, 3, 'a'}}, ErrConflictingFix},
+		{[]plaintextFix{{0, 3, 'a'}, {0, 3, 'a'}}, nil},
+	} {
+		if _, err := crackManyTimePad(ciphertexts, logLikelihoodScorer{}, test.fixes); !errors.Is(err, test.err) {
+			t.Errorf("fixes %v: got '%v', want '%v'", test.fixes, err, test.err)
+		}
+	}
+}
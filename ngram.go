@@ -0,0 +1,225 @@
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Symbols n-grams are made of. Letters are case folded, whitespace is one
+// symbol and every other printable byte another. Bytes that aren't printable
+// break up n-grams and are scored with ngramBadByte.
+const (
+	ngramSpace   = 26
+	ngramOther   = 27
+	ngramSymbols = 28
+)
+
+// ngramMagic starts a saved model. It is followed by the order as a byte, the
+// number of n-grams as a uvarint and then each n-gram as a uvarint delta from
+// the previous one and a uvarint count.
+const ngramMagic = "ngrm"
+
+var (
+	ErrInvalidNgramOrder = errors.New("trainNgramModel: order must be 2, 3 or 4")
+	ErrEmptyCorpus       = errors.New("trainNgramModel: no n-grams in corpus")
+	ErrInvalidNgramModel = errors.New("readNgramModel: invalid model")
+)
+
+// ngramModel holds log probabilities for the n-grams of some order seen in a
+// corpus. It is a scorer: the score is the negated mean log probability of the
+// n-grams in the input.
+type ngramModel struct {
+	n      int
+	counts map[uint32]uint32
+	logp   []float32 // Indexed by packed n-gram, floor for unseen ones
+	floor  float32
+}
+
+// ngramBadByte is how many times less likely than an unseen n-gram a byte that
+// isn't printable is taken to be, in log space.
+const ngramBadByte = 2
+
+func ngramSymbol(c byte) int {
+	switch l := foldLetter(c); {
+	case l != 0:
+		return int(l - 'a')
+	case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+		return ngramSpace
+	case isPrintable(c):
+		return ngramOther
+	default:
+		return -1
+	}
+}
+
+// forEachNgram calls fn with every n-gram of bytes packed in base ngramSymbols,
+// and bad for every byte without a symbol.
+func forEachNgram(bytes []byte, n int, fn func(key uint32), bad func()) {
+	size := uint32(math.Pow(ngramSymbols, float64(n)))
+	var key uint32
+	run := 0
+	for _, c := range bytes {
+		sym := ngramSymbol(c)
+		if sym < 0 {
+			run = 0
+			bad()
+			continue
+		}
+		key = (key*ngramSymbols + uint32(sym)) % size
+		if run++; run >= n {
+			fn(key)
+		}
+	}
+}
+
+// trainNgramModel counts the n-grams of order n in corpus.
+func trainNgramModel(corpus []byte, n int) (*ngramModel, error) {
+	if n < 2 || n > 4 {
+		return nil, ErrInvalidNgramOrder
+	}
+	counts := make(map[uint32]uint32)
+	forEachNgram(corpus, n, func(key uint32) { counts[key]++ }, func() {})
+	if len(counts) == 0 {
+		return nil, ErrEmptyCorpus
+	}
+	return newNgramModel(n, counts), nil
+}
+
+func trainNgramModelFile(filename string, n int) (*ngramModel, error) {
+	corpus, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	return trainNgramModel(corpus, n)
+}
+
+func newNgramModel(n int, counts map[uint32]uint32) *ngramModel {
+	var total float64
+	for _, c := range counts {
+		total += float64(c)
+	}
+	// Unseen n-grams get a hundredth of a count.
+	floor := float32(math.Log(0.01 / total))
+	logp := make([]float32, int(math.Pow(ngramSymbols, float64(n))))
+	for i := range logp {
+		logp[i] = floor
+	}
+	for key, c := range counts {
+		logp[key] = float32(math.Log(float64(c) / total))
+	}
+	return &ngramModel{n, counts, logp, floor}
+}
+
+func (m *ngramModel) score(bytes []byte) float32 {
+	if len(bytes) == 0 {
+		return 0
+	}
+	sum, count := m.logProb(bytes)
+	if count == 0 {
+		// Too short to hold a single n-gram.
+		return -m.floor
+	}
+	return -sum / float32(count)
+}
+
+// logProb returns the sum of the log probabilities of the n-grams and bad
+// bytes in bytes, and how many there were.
+func (m *ngramModel) logProb(bytes []byte) (float32, int) {
+	var sum float32
+	count := 0
+	forEachNgram(bytes, m.n, func(key uint32) {
+		sum += m.logp[key]
+		count++
+	}, func() {
+		sum += ngramBadByte * m.floor
+		count++
+	})
+	return sum, count
+}
+
+// save writes m in the format described at ngramMagic.
+func (m *ngramModel) save(w io.Writer) error {
+	keys := make([]uint32, 0, len(m.counts))
+	for key := range m.counts {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return keys[i] < keys[j] })
+	bw := bufio.NewWriter(w)
+	bw.WriteString(ngramMagic)
+	bw.WriteByte(byte(m.n))
+	buf := make([]byte, binary.MaxVarintLen64)
+	putUvarint := func(x uint64) {
+		bw.Write(buf[:binary.PutUvarint(buf, x)])
+	}
+	putUvarint(uint64(len(keys)))
+	var prev uint32
+	for _, key := range keys {
+		putUvarint(uint64(key - prev))
+		putUvarint(uint64(m.counts[key]))
+		prev = key
+	}
+	return bw.Flush()
+}
+
+// readNgramModel reads a model written by save.
+func readNgramModel(r io.Reader) (*ngramModel, error) {
+	br := bufio.NewReader(r)
+	header := make([]byte, len(ngramMagic)+1)
+	if _, err := io.ReadFull(br, header); err != nil {
+		return nil, ErrInvalidNgramModel
+	}
+	n := int(header[len(ngramMagic)])
+	if string(header[:len(ngramMagic)]) != ngramMagic || n < 2 || n > 4 {
+		return nil, ErrInvalidNgramModel
+	}
+	size := uint64(math.Pow(ngramSymbols, float64(n)))
+	count, err := binary.ReadUvarint(br)
+	if err != nil || count == 0 || count > size {
+		return nil, ErrInvalidNgramModel
+	}
+	counts := make(map[uint32]uint32, count)
+	var key uint64
+	for i := uint64(0); i < count; i++ {
+		delta, err := binary.ReadUvarint(br)
+		if err != nil || (i > 0 && delta == 0) {
+			return nil, ErrInvalidNgramModel
+		}
+		c, err := binary.ReadUvarint(br)
+		if err != nil || c == 0 || c > math.MaxUint32 {
+			return nil, ErrInvalidNgramModel
+		}
+		if key += delta; key >= size {
+			return nil, ErrInvalidNgramModel
+		}
+		counts[uint32(key)] = uint32(c)
+	}
+	if _, err := br.ReadByte(); err != io.EOF {
+		return nil, ErrInvalidNgramModel
+	}
+	return newNgramModel(n, counts), nil
+}
+
+var (
+	englishNgramsOnce  sync.Once
+	englishNgramsModel *ngramModel
+)
+
+// englishNgrams is the quadgram model in englishNgramData, read the first time
+// it is needed.
+func englishNgrams() *ngramModel {
+	englishNgramsOnce.Do(func() {
+		m, err := readNgramModel(strings.NewReader(englishNgramData))
+		if err != nil {
+			panic("englishNgrams: " + err.Error())
+		}
+		englishNgramsModel = m
+	})
+	return englishNgramsModel
+}
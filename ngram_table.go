@@ -0,0 +1,408 @@
+// Code generated by go test -run TestEnglishNgramData -update. DO NOT EDIT.
+
+package main
+
+// englishNgramData is the quadgram model trained from data/corpus.txt, as
+// written by ngramModel.save.
+const englishNgramData = "" +
+	"ngrm\x04\x9a/\x9d\x06\x01\x17\x06W\x01W\x01f\x06\x04\x02\x10\x03G\x02\t\t\x01\x01i\x03<\x01" +
+	"\x94\x02\x03\x06\x01'\x01\x0f\x01\b\x02\x01\x03A\x02\x12\x01G\x01\r\x03\xb8\x01\x02.\x01\x04\x03\x06\x02\x06\x01" +
+	"\a\x01\f\x01\xba\x02\x01\t\x01\x14\x01\b\x03\x01\x02b\x01#\x01(\x02 \x03\xf4\x01\x03\b\x01f\x02\x1e\x04" +
+	"\x01\x02\x02\x02\x01\x02\a\x01\x01\x01\x01\a\x02\x02\x03\x02\x01\x01\x03\x01 \x03\xc2\x02\x01\x0f\x01\xc4\x04\x01\t\x01" +
+	"\x04\x01\x88\x03\x04\x16\x01\xea\x01\x01k\x02\x0f\x01\b\x03c\x01\xb5\x01\x01\x01\x01=\x01\xae\t\x02A\x01\x9f\x01" +
+	"\x03\x01\x03!\x04\x01\x01\x03\x01\x03\x01\a\x01\b\x04\x01\x02g\x02\b\x01\x01\x01\x05\x01\x04\x01\v\x02\x05\x01\x1f" +
+	"\x01\xee\b\x02\x04\x02\t\bc\x03\xa6\x04\x01\n\x01\xd5\x01\n\x02\x01\x06\x01H\x02\x06\x02\x04\x01\x06\x02\n\x06" +
+	"\x02\x1e\x01\x03\x0f\x01>\x01D\x01\x1c\x01\x1c\x04\n\x01F\x02q\x01\x04\x01\x03\x01\x04\x01\x02\x01\x01\x02\x02\x03" +
+	"\x01\x01\x01\x02\x03\x02 \x02\x01\x01%\x01Y\x01\r\x05\x01\x02`\x05\xaa\x01\x05*\x01\x99\x02\x02\x13\x01+\x01" +
+	"6\x06\x04\x01\"\x01\x04\x03\bj\x01\x03=\x03\x18\x01 \x01\x15\x01'\x02\x13\x01G\x01!\x02e\x01\x0e\x01" +
+	"\x06\x01\v\x01\a\x01\b\x04\x85\x01\x04\a\b\x1e\x02\x02\x02\x02\x04\x03\x01\x01\x04\x03\x01\x01\x01\x01\x04\x01\x03\x04" +
+	"\x01\x01\x05\x01\x01\x02\x03\x02\x02\x1e\x04\xe1\x03\x02\x9e\x04\x01\xbb\x01\x02\x04\x03\x06\x01\n\x03V\x02\xa1\b\x03E" +
+	"\x01\x0e\a\b\x06\x01\x01\b\x01\n\x01\t\x12\"\x064\x01\v\x01\x02\x03\x05\x01\x01\x01%\x01\r\x01\r\x02\x06" +
+	"\x03\x14\x03\x04\x01\x12\x01\x1d\x01\x1d\x01\"\x01&\x03\x10\x02\x1e\x03\x01\x01\x05\x01\x03\x03\x01\x03\x10\x01\x01\x01\x01" +
+	"\x01\"\x01j\x04\x1e\x01\x02\x01\x03\x02\x06\x01\x01\x02\x01\x01\x01\x02\x04\x02\x01\x04#\bu\x01\x17\x01>\x02%" +
+	"\x01\x01\x02D\x02c\x05\x17\x01P\x01\x04\x01\f\x02\f\x04\x04\x01\x12\x02\x01\x01\x12\x02\x97\x01\v\x01\x03\x01\x04" +
+	"\x01\x03\x01\x02\x01\x02\x01\x04\x01\x04\x01\x05\x01\x03\x02\x01\x02\x04\x04\x01\x01\v\x01\x15\x02\x02\x01\x02\x02\x01\x1e\x03" +
+	"A\x024\a\b\x04\x01\x01\x05\x03\x01\x01\x03\x01\x05\x06\x01\x03=\x05\x16\x02\x01\x01\x03\x01\x02\x02\t\x03\x01\x13" +
+	"\x04\x01\x01\x02\x02\x03\xa4\x01\x01\t\x01p\x01\x06\x04\a\x02\x03\x01\x03\x01\x1c\x06\x97\x01\a\x01\x01\x01\x03\x01\x02" +
+	"\x02\x02\x01\x02\x01\n\x01\t\x03\x03\x01\x02\x01\x06\x01\x01\x04\x05\x01\x15\x03\b\x02\x01\x13\x02\x03\x01\b\x05\xb1\x01" +
+	"\x02\xcd\x02\x03\x04\x01\x1b\x01\x11\x02\xdf\x02\x01\x02\x01\x05\x02\b\x1bc\x03\xbb\x04\x01\xd5\x01\x01\xa5\x02\x01\xc6\x01" +
+	"\x02\f\x01\xa9\b\x01\xa5\x02\x03\x01\x01\xc5\x01\x03\x01\x01\x04\x02\x01\x01\x01\x01\x01\x03\x03\x01\x01\x01\x06\x01\x01\x03" +
+	"\x01\x01\"\x06\x01\x01\x82\x01\x01\xb9\x04\x01h\x01\x18\x01\x15\x01\a\x03\x03\x01\v\x02\x04\x02\x04\x01\x06\x04*\x03" +
+	"\x04\x01\x04\x02\x06\x01\x03\x01\x13\x01\x06\x02\x03\x03C\x01\x14\x01\x10\x02\x14\x04\b\x01\x06\x02\x0e\x01\x0e\x01\x0e\x01" +
+	"\x04\x03;\x01\x04\x01\x03\x01\"\x02\b\x01\x04\x01\x1c\x01\x04\x02\x05\x01\x03\x01\x03\x03\x11\x01\t\x01\x03\x01(\x02" +
+	"\x18\x04\a\x02\x01\x01\x06\x01.\x01\n\x01\x8e\t\x01\x18\x01\v\x010\x05\a\x01\xb1\x01\x03\xdc\x03\x06\xd1\x10\x02" +
+	"2\x027\b\x1d\a\x16\x02=\x01O\x01\x03\x01\b\x012\x04c\x01\x12\x01\x01\x02\a\x03\x15\x02\x1c\x01\x03\x01" +
+	"\xae\x01\x02\x02\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01\x02\x03\x01\x01\x01\x02\x01\x01\x02\x03\x04\x04\x01\xdb\x0f\x01\xe6\x05" +
+	"\x01\x03\x03*\x01S\x01-\x01\x12\x04\x01\x02\xd3\x02\x02\xb0\v\x01\x88\x01\a\x01\x01W\x02\v\x01\x05\x02\xc2\x02" +
+	"\x01\x86\x01\x04\x87\r\x02K\x01\x10\x01\\\x01t\x02V\x04W\x012\x01&\x01\x02\t\r\x01<\x01\x12\x01\x94" +
+	"\r\x01\x10\x01\x03\x02_\x03y\x01\xaf\x01\x01\x02\x01\xf3\x03\x03\x92\x02\x01\x06\x02\x83\x01\x01\xcf\x01\x01\x91\x06\x01" +
+	"\x02\x01\x84\x04\x01\x02\x02\x05\x01\x13\x012\x15\x01\x01\xe9\x19\x02\x01\x01\x01\x01\x05\x02\f\b\x03\x02\xbd\x06\x01\xe8" +
+	"\x01\x01\x9a\f\x01R\x04\x0f\x02\x06\x012\b\x01\x01Y\x01\r\x01\x13\x02\x18\a\x04\x01\x06\x01 \x03\xf0\a\x02" +
+	"p\x01\x92\x02\x01\x05\x01\x03\x01\xa3\x01\x01\x02\x01\x01\x01\xeb\a\x01a\x04\x01\x01\x86\x01\x03J\x01\x15\x01+\x03" +
+	"p\x02\x1b\x03\x01\x01\a\x02\xc6\x01\x03\x02\x01\x02\x01\x01\x01\x01\x01\x01\x04\x01\x01\x06\x03\x04\x03\x01\b\x03\x01\x05" +
+	"\x01\x1b\t\xa6\f\x01\x04\x02\x05\x02\x04\x01c\x02\r\x02c\x03\t\x03\xa9\x01\x01\x04\x01\xbe\x02\x06\x01\x01\x01\x01" +
+	"\x01\x01\x01\x01\x01\x01\x01\x02\x01\x04\x01\x04\x04\x02\x01\x01\x01\x05\x01\x03\x02\x01\x01\x01\x01\x05\x03\x01\x02\x01+\x02" +
+	"r\x01\x06\x01\xef\x01\x01*\x01\x13\x02/\x01\x1e\x020\x01(\x01p\x01\xdf\a\x02j\x02_\x01.\x01\xdf\x02" +
+	"\x01\x02\x01\x04\x01\x01\x01\x04\x01\x02\x01\x02\x019\x02_\x02\x11\x02k\x01\x01\x01\x02\x01\xab\x01\x01\x9a\x01\x01\x0f" +
+	"\x01\x80\x0e\x02%\x01Y\x02\xa6\x01\x03\v\x01\x12\x05\x04\x01\x04\x03\x03\x03\x0f\x02\x01\x01\x02\x02\b\x01\x05\t\x01" +
+	"\x03\x02\x02\x12\x01I\x01\x03\x01\x01\x01[\x03\x02\x04\x04\x03\x0f\x02\xe2\r\x01\r\x01\x04\x02]\x05\x03\x01\x10\x01" +
+	"a\x01\b\x01\a\x01\xa3\x01\x03\x04\x02\x04\x01\x94\x02\x01\x81\a\x01`\x02o\x01\v\x01\x01\x03\a\x01\xa4\x01\x02" +
+	"\x97\x01\x01\x11\x03\x9b\x01\x01\r\x01\x02\x05#\x01\xb6\x02\x04\x13\x01^\x02\a\x014\x01\x04\x02\t\x01\x1e\x01\x8f" +
+	"\x1a\x01\xd8\x06\x01F\x01\xe7\f\x01S\x02m\x01\x87\x01\x026\x01\x1a\x02|\x01\b\x02\x01\x02\xf2\r\x03\xe3\x02" +
+	"\x01\x86\x02\x01-\b\b\x01\x06\b\t\x01\"\x01\a\x02\x19\x06\x12\x02\x05\x01\f\x03:\x02\x83\x01\x042\x02\t" +
+	"\x03\b\x02)\x018\x02\x04\x03\x05\x01\x05\x02\x01\x02\a\a\n\x03\a\x02\x04\x02\a\x01\x02\x01\x11\x015\x02\x82" +
+	"\x01\x01\x03\x02\x01\x01\x05\x02\x01\x01\b\x01 \x01\x01\x01\x84\a\x01\x17\x01\x01\x03b\x01\xc0\n\x01\x06\x01%\a" +
+	"\x13\x02#\x04\x05\x01\xf9\x01\x02\x06\x04A\x01-\x02\x18\x02\x02\x01\r\x01\x03\x01\x01\x04\xc7\x06\x01\xdf\b\x02\xb9" +
+	"\x04\x01\xc7\x02\x02\x02\x01\xa4\n\x01\xcc\x01\x01\x95\x01\x01\x12\x02\x01\x02\x05\x02\x17\x02\x12\x03\x98\x01\x02;\x01\t" +
+	"\x0e\r\x01>\x01\x18\x01\r\x01\x06\x01\x05\x02\x1e\x01\xb8\f\x01l\x01c\x01\x10\x01\x04\x01\x9e\x01\x01\a\x01\x98" +
+	"\x01\x01\xee\x04\x01\x8e\x01\x01\xb6\x01\x02\x05\x01\x02\x01\x01\x01\x01\x01\x05\x03\x04\x01\x01\x02\x03\x02 \x04\xb6\x06\x01" +
+	"\x18\x02\x80\x02\x01\xa4\x01\x01\x04\x01H\x01\xf1\x01\x03\xd5\x01\x01\xab\x16\x01\t\x01\x05\x01\x01\x01\xb5\x06\x01\x01\x03" +
+	"\x01\x01\x02\x01\x06\x03\x01\x03\x01\x04\x02\x01\x02\x01\x01\x04\x01\x04\a\x05\x02\x01\x04\x05\n\x02\x03\x01\x03\x01\x04\x06" +
+	"\v\x01\x04\x01\x03\x01\x12\x05\x04\x01\x06\x01\x03\x01\x18\x03\x03\x03\x02\x01\x03\x04\x02\x01\t\x01\x04\x04\x06\a\x03\x01" +
+	"\x13\x01\x14\x06\x04\x05\x04\n\x06\x02\x1b\b\x05\x01\x01\x03\a\x02:\x01\x04\x02\n\x01\x0e\x02\x04\x03\x04\x02\x06\x01" +
+	"\n\x05\b\x01\n\x11\x0f\x01\x01\x01\x03\x06\b\x02\x02\x01\x02\x04\v\x01\x04\x01\x03\x01\x01\x02\x03\x01\x06\x04\x1f\x02" +
+	"\f\x03\n\x01\x0e\x01\x02\x01\x02\x05\x03\a\x01\x01\x06\x03\x01\x01\x04\x01\x01\x02\f\x01\x03\x1e\a\x0f\x03\x01\x1a\x02" +
+	"\x03\x02&\x04\x04\x01\x03\x01\x01\b\x06\x02.\x01P\x01\x0e\x01\xe6\x05\x02\x01\x01\x01\x01\x03\x02\x02\x03\x01\x06\x03" +
+	"\x01\x01\x01\x03\x01\x03\x01\x01\t\x03\x03\x04\x01\x01\x02\x1b\x01>\x01\x03\x01\x19\x01\x04\x01\x10\x01\x02\x05\x01\x02\xdf" +
+	"\x01\x011\x01\a\x02Y\x02\x01\x01\x04\x03\x03\x01\x02\x01\x05\x04\x01\x03\a\b\x01\x06\x05\x01\x04\x01\x06\x05\x06\x02" +
+	"\f\x02\x03\x01\a\x01\x06\x01\x06\x06\x01\x01\x14\x02\r\x01\x88\x01\x01\x16\x01\xad\x03\x02Q\x01\xac\x03\x01\x05\x01\x05" +
+	"\x02\b\x01V\x01\x12\x02B\x02\b\x01X\x02\x01\x01G\x02\x04\x018\x01\x04\x01\x12\a\x13\x02\xfb\x02\x044\x01" +
+	"\x02\a\xb2\x01\x02\x9e\x01\x01\t\x01\x9d\x01\t\x01\x05\x02\x02\x01\x03\x01\x05\x02\f\x01\t\x04\x01\x01\x01\x01\x04\x02" +
+	"\x01\x01\x02\x01\x04\x01\x10\x01\x01\x02\x06\x05\x01\x1b\rd\x01\f\x01\x01\x01/\x01\x8c\x01\x01\b\x02\x01\x02\t\x03" +
+	"\x12\x04\x06\x02\x16\x01\x1c\x0f\x01\x01!\x01\a\x01\r\x01\x02\x01\x01\x018\x01/\x02\t\x04\x91\x01\x03\x04\x01\x14" +
+	"\x01\x03\x01\x05\x01\x05\x01\x01\x01\x05\x03#\x03\x92\x01\x01\xbc\x01\x01M\tz\x01\x1c\x01\xb5\x01\x01*\x01\x13\x03" +
+	"\xdc\x01\x02\x01\x01\x04\x01\xee\x01\x023\x01(\x01\xe8\x04\x01\t\x01\xa3\x04\x01\x9f\x01\x01$\x01\x84\x01\f%\x03" +
+	"5\x03\xd0\x04\x01\x93\a\x01\xc6\x01\x01\x12\x01#\x01\x01\x01c\x01\f\x04\x01\x01\x13\x01\n\x01\x1a\a\x01\x06:" +
+	"\x01\x01\x01\x02\x01\x02\x02\a\x03K\x04\n\x01\f\b\x01\x03\x01\x01\xac\x01\x01\x1c\x01\x16\x01\"\x06j\x06\x01\x03" +
+	"\x1d\x01\x05\x01\x02\x01\x04\x01\b\x01\x02\x01!\x01\n\x02\t\x01\x0f\x04S\x02\t\x02\x01\x02\r\x01V\x01\xb9\x01" +
+	"\x01\x02\x03\r\x01\x03\x01\f\x01E\x03\x16\x01\xc7\x01\x01\v\x01\x06\x01\x01\x02\x01\x01\x02\x01 \x03\x01\x02\x02\x01" +
+	";\x04\x03\x02\a\x01\x12\x01\n\x01\x04\x02\x02\x01\x06\x03\x01\x01\x04\x01\x0e\x04\x04\x06\x05\x02\x1e\x01\v\x02\x06\x01" +
+	"\x93\x01\x03$\x04,\x01`\x04\x04\x01\x14\x01\x04\x03\x04\x05\x03\x01\x06\x02\x01\x03\x02\x01\x04\x01\x02\x14\x01\a\xa9" +
+	"\x01\t\x01\x02\x01\x02\x01\x01\x04\x02\x01\x06\x03\x02\x02\x02\x05\x03\x01\x04\x01\x01\x02\x02\x02\x01\x16\x01\b\x06\xb1\x03" +
+	"\a\xf2\x02\x02_\x01\x03\x01\n\x01\xbf\x01\x02\x10\x01>\x02\"\x01\x8e\x02\x014\x01 \x01\xb2\x04\x03\b\x02\xd9" +
+	"\x01\x02\n\x03\x01\x01\x01\x01\x06\x01)\x02\x18\x01\x1f\x03\x02\x02\r\x02\b!\x01\x06\x05\x01\x04\x02\x1c\x01\x10\x02" +
+	"\x04\x01 \x02\t\x03\x01\x02\x04\x03\x03\x01>\x01\x01\x01\x0e\x01\x16\x01\x14\x02\x04\x03\b\a\x0e\x01\x16\x01\x19\x01" +
+	"C\x03\x01\x01\x03\x03\x0f\x02\a\a\x01\x02\x01\x04\x18\x03 \x02\x04\x04\x04\x01\x14\x02K\x02\a\x11\x01\x01\x1d\b" +
+	"\x01\x01\x01\x01\x03\x05\x01\x03\x01\x05\x01\a\x02\x01\x01\x03\x01\x05\x01\x01\x01\n\x01\x01\x03\v\x01\r\x01\x01\x02\x05" +
+	"\x02\x01\n\x01\x14\x16\x7f\x02\x04\x02\t\aY\x01\x03\x03\xad\x01\x01\x01\x01\x10\x01\x04\x02\n\x01F\x01\b\x03\x06" +
+	"\x01\f\b\x01\x05\x01\x02\x04\x01\x04\x03\x06\x01\x03\x01\x01\x01\b\x06\r\x01\x9d\x01\r\x01\x02\x02\x02\x04\x01\x01\x03" +
+	"\x03\x01\x01\x02\x01\x01\x01\x05\x03\x02\x01\x02\x01\x05\x01\x01\x02\x02 \x14\x01\x01\t\x01\n\x01k\x01\x04\x01\t\x01" +
+	">\x05\n\x01z\x01P\x01@\x016\x02\x06\x01\x04\x02\x03\x02\"\x01+\x01N\x02\x1e\x01\f\x02\x02\x02\x03\x01" +
+	"\x01\x01\x01\x02\x01\x01\x01\x01\x01\x01 \a\x01\x02\x8e\a\x04\x04\x19\t\x01Y\x01\b\x03\x02\x01\x01\x01\xad\x01\x02" +
+	"\xd4\x03\x01e\x01\x04\x01\xbf\x01\x01\x8b\x01\x01\xc7\x01\x01\x01\x01\x04\x02\x01\x01\x01\x01\x05\x01)\x01>\x01\xb1\x01" +
+	"\x01\xbb\x01\x01\x04\x01l\x01\r\x03\t\x01\x01\x01\xdf\x01\x01\x84\x01\x03\xd6\x04\x02\x01\x01\x02\x01\x03\x01\x02\x01\x04" +
+	"\x01\x06\x01\x04\a\x11\x01\x10\x01\x83\x01\x01\b\x01\x01\x01b\x01\xa4\x04\x01\x01\x01\x01\x05\x02\x01\x01\x03\x05\x05\x01" +
+	"\x02\x01\x0e\x04\x04\x01\x03\x01\x02\a\a\x02\x03\x04\x05\n\x04\x03\x01\x03\x01\x04\x02\x04\x05\x04\x01\x03\x04\x01\x01\x03" +
+	"\x03\x03\x0e\x03\x04\x03\x01\b\x03\x04\x06\x04\x05\x06\x03\x03\x02\v\x03\x02\x01\x06\x01\x05\x06\x02\x02\x06\x01\x02\x01\x05" +
+	"\x03\x04\x05\x04\x05\x06\f\x03\x02\x03\x01\b\x01\x04\x01\x04\x01\x06\x02\x03\x05\v\x13\x04\x04\x04\x03\x06\t\x06\x02\n" +
+	"\x01\n\x01\x01\n\x04\x01\x01\t\x01\x03\b\x01\x01\x03)\x01\x0f\x05\b\x02\x06\x02\x0e\a\x04\x06\x04\x02\x06\x05\n" +
+	"\x03\x04\x03\x04\x05\x04\x01\x06\b\x0e\x01\x01\x02\x01\x01\x03\x1d\b\x04\x02\x01\x02\x05\x02\x01\x01\a\x01\x01\a\x05\x04" +
+	"\b\a\x04\x03\x04\x03\x06\x03\x03\x1c\x01\b\x06\x04\x04\x04\a\x14\x06\x02\x01\x02\n\x03\x06\x01\x03\x06\x03\x01\x02\x04" +
+	"\a\x01\t\b\x01\a\x1f\x01\x02\x06\x17\x03\x05\x18\x03\x02\x01\x03\x04\x0e\x01\x10\x01\b\x10\x04\b\x03\x03\x01\b\x06" +
+	"\v.\x03\n\x04\\\x01B\x01X\x01\xfe\x02\x03\xc6\x01\v\x01\x05\x03\x01\x01\x03\x02\x05\x01\b\x01\x01\x02\x01\x01" +
+	"\x02\x01\x02\x01\x03\x04\x05\x01\x04\x03\t\x02\x01\x02\x06\x01\x02\x1b\b>\x02\x0f\x02\x01\x01\xa5\x01\x02N\x05\a\x01" +
+	"\x12\x05\x88\x01\x01\x16\x04%\x02\xb6\x14\x01\x02\x018\x030\x06\b\x01\xbc\x01\x04\b\x01)\x01l\x02\x1b\x015" +
+	"\x01V\x01]\x01\x01\x01\v\x01#\x03\n\x01j\x02\v\x01\x04\x03a\x03\v\x02\xf2\x03\x01\v\x01\x01\x02#\x01" +
+	"\xde\f\x01\x04\x02\x18\x01#\x05\x06\x01\xbe\x01\x02-\x01\x03\x03\x01\x02\x04\x02\x12\x01\x01\x01Y\x01\b\x01\x06\x05" +
+	"\x11\x01\f\x01\x1c\x02)\x048\x01\xba\r\x01\x01\x01\xda\x01\x01\xaf\x01\x01\x9e\x02\x01\xb5\x0f\x01T\x01M\v\x02" +
+	"\x01\x06\x05\a\x06\a \x01\x02?\x01\a\x02\x04\x05]\x01\x9f\r\x01g\x01\t\x01\x97\x02\v\r\x01\xfd\b\x01" +
+	"u\x01\x0e\x05\xf0\x04\x01\x1d\x01\xc1\x02\x02\x0f\x01\"\x01\x90\x01\x01(\x02\xb9 \x01\x04\x01\b\x01\r\x04\x06\x03" +
+	"\a\x01\x1f\x01\x03\x01\v\x01\x04\x02\r\x01\x03\x01\x15\x02\b\x01\a\x01\x0e\x02\x03\x02\x0f\x01\n\x02\x03\x01\v\x02" +
+	"\x04\x03\x04\x06\x06\x02\x06\x01\x15\x03\x04\x01\x02\x04\a\x01&\x01\x14\x03\b\x01\x06\x02\x0e\x03\x04\x01\n\x01\n\x03" +
+	"\x04\x02\x04\x01\x19\x01\x13\x02\f\x01X\x02\x06\x02\x06\x02\x0f\"\x01\x03\f\x01\x17\x01)\x01\b\x01\xe6\x01\x01?" +
+	"\x01\xd3\x04\x01\b\x01\t\x01\x02\x02\b\x02\xc9\x01\x01A\x01\x06\x01\x81\x01\x01k\x03q\x03p\x01\xee\x13\x03\x01" +
+	"\x01\x81\x02\x02\x84\x01\x01\x02\x02\x1c\x01\t\x01\f\x02\a\x05\xaf\x01\x02\x02\x01\x02\x02\x03\x02\x02\x02\x03\x01\x01\x01" +
+	"\x1c\x01\b\x05\xca\b\x01\x82\x04\x01\xa2\x02\x01\xcc\x01\x03\x01\x01\r\x02\b\x14\x01\x05\xb0\x01\x01\f\x02\x18\x01\v" +
+	"\x02\"\x01b\x01\xf0\x01\x03\a\x01\r\x01\x0f\x01R\x03$\x01\x1d\x02(\x03\xed\r\x02f\x01\x15\x02\x02\x02\x01" +
+	"\x02\xaf\x04\x02\xd9\a\x02\xed\x04\x01\v\x01#\x01p\x02\x01\x01~\x03\x9e\x01\x05\xa3\x01\x01\"\t\x96\x01\x02(" +
+	"\x02\xaf\f\x01\x05\x01\x01\x01]\x06\x04\x03\x0e\x01j\x01\x01\x01\x06\x01\xa7\x01\x01\x02\x01\x02\x02\xcf\b\x01\x03\x02" +
+	"\x06\x02\x05\x01#\x03\xa3\x06\x01y\x01\xaa\x01\x01\xdc\x18\x01\x96\x03\x01\xbb\x06\x01\f\x05\r\x02\x06\x04\x14\x01\x12" +
+	"\x01\x16\x02\x06\x02\x1a\x01\x01\x01\x05\x01\x05\x01\x05\x02\x0e\x01\x03\x01\x1c\x01\v\x01\x04\x03\x04\x01\x06\x01\x1b\x05\x06" +
+	"\x03I\x01\x14\x02\x04\x01\n\x01\x12\x01\n\x02\x13\x06\b\x02\a\x02\x01\x01\a\x01\x04\x02\a\x01\x06\x01+\x02\x18" +
+	"\x01\x0e\x03\x06\x01\x0f\b\a\x06\x03\x01\x1a\x04\x1b\x01\x0e\x01\a\x02\x01\x04\xf8\x06\x01\x01\x01\x01\x02\x04\x02\x02\x01" +
+	"\a\x01\x02\x01\x01\x01\x01\x02\x03\x01\x90\x01\x14\x01\x01\f\x01r\x01\x05\x01M\n0\x05\x03\x02\x14\x05\x01\x01," +
+	"\a\x03\x01)\x01\x01\x01\x04\x01\a\x01\x06\x01\v\x01\x16\a\x06\x01\x03\x01\x01\x01\n\x01\x01\x01\aB\x01\x05!" +
+	"\x15\x04\x03\xd8\x04\x01\xa5\x0f\x05\x04\x01E\x01\x14\x05\f\x02w\fX\x02\x0f\x01\n\x03\b\x06\x01\x05\x05\x06\x16" +
+	"\v\x01\x01Y\x1d\x01\x02\x03\x02\x03\x01\x02\x01\x05\x04\b\x1b\x01\a\x05\a\x17\x01\b\x02Z\x01E\n\x01\x01\x1d" +
+	"\x05\x01\a\x01\x12\x01\x06\x01\t\x01\f\x01\x05\x01\r\x01\x03\x01\x01\x02\a\x01\t\x01\x06\x01\b\x01\x13\x01\x01\x01" +
+	"\f\x01\x1a\x01\f\x01\x03\x01\x01\x01\x17 \x01\xea\x12\x01\a\x166\x02\x10\x01*\x04\x89\x01\x02\n\x01 \x03\b" +
+	"\x05\x01\x02\x05\x01\x02\x14\x04\a\r\x01\x03\x029\x01S,\x01\x05\x05\x01\xd2\x13\x01Z\x01y\x01\x95\x06\x01\xea" +
+	"\x05\x01\xdb\x01\x02\x01\x04\b\x03\x14\x02\x1c\x01\n\x01\x11\x01\b\x01\x15\x038\x01\x04\x01\t\x01\x02\x01\r\x052" +
+	"\x01\b\x06\x05\x03\x06\x01\x01\b\x01\x01)\x01\x04\x01\x12\t[\x02\x02\x03\x04\x01\a\x02\xba\r\x01\x12\x01o\x01" +
+	"\xa0\x01\x02\t\x03\xdd\b\x01\x98\x01\x01\x0e\x02\x1f\x01\xf3\x02\x02\xc5\x01\x05\x03\x01\x02\x01\x02\x02\x01\x02\x03\x01\x03" +
+	"\x03\x05\x05\x0e\x01\x15\x04\xd2\x02\x01\"\x01{\x01\f\x03\x19\x01\xe0\x17\x01\xdc\x02\x02\x9d\x06\x02\x02\x02\x05\x01\b" +
+	"\v\x13\x01\v\x01\x04\x01\x03\x01\a\x02\x06\x02\f\x04 \x01\r\x01\x0f\x02\x06\x01\x03\x01\x13\x01\x06\x01\x0e\x04\x04" +
+	"\x01\x04\x03\x06\x01\x1a\x01\x01\x01\x05\x02\x01\x02\a\x01V\x02\x04\x01\x04\x01\t\x01\x19\x01\x13\x06\b\x03\x06\x01\x01" +
+	"\x02\f\x01\x04\x01\t\x01\x03\x01,\x01\x1b\x01\f\x01\x01\x01\x0f\a\x01\x01\x06\x01\x1b\x01\x13\x01\x18\x01F\x01\x94" +
+	"\x04\x01\xa7\x02\x01\a\x01\x03\x01\a\x02\x01\x02\x03\x01\x04\x02E\x01\x85\x01\x01\x93\x01\x01\x0f\x038\x02\x92\x01\x01" +
+	"\xf9\x02\x03\t\x01\x91\x05\x04\b\b`\x01\x17\x10\x01\x029\x01\x1a\x16\x02\x01\x04\x01\x15\x01'\x01\x03\x01\x0f\x02" +
+	"\xe2\x01\x01\b\x01\f\x03\x13\x03\x7f\x01\x1e\x01\x03\x01\xa5\x01\x01\r\x03\x04\x03\x01\x01\b\x01\x94\x02\x01\xba\x02\x02" +
+	"\a\x02\x06\x02*\x01=\x012\n)\x01\x0f\x01\x01\x01\x90\x01\x055\x01\x02\x01\x11\x04_\x01-\x01\a\x06\x01" +
+	"\x03\f\x01\r\x01$\x01*\x01\xa0\x02\x03\x01\x03\x05\x01\x88\x03\x01\xc8\x01\x01\v\x01\xea\x01\x01\n\x01\b\x14\a" +
+	"\x02\x01\x01\x91\x01\x02\x16\x02\x01\x01\xad\x01\x01\x87\x15\x03\x0f\x05\x01\x01\x87\x06\x02\b\x02\x01\x01Y\x02T\x01\x04" +
+	"\x01\n\x01\b\b\x01\x01K\x01x\x01\xa8\x01\x03\x01\x01\x1d\x02\x05\x02\t\x01\b\x01 \x04\x84\x01\x03\b\x05\x01" +
+	"\x02`\x01i\x02P\x01\x04\x01T\x03\xdc\x01\x02\x05\x01\a\x01\x02\x01\b\x01 \x02\r\x01\x06\x02\x02\x01)\x02" +
+	"\x04\x02\x03\x01\x06\x03\x0f\x01\x04\x01\n\x03\b\t\x01\x02\x04\x04\b\x01\x02\x01\x05\x04\b\x03\x16\x01\x10\x01\x03\b" +
+	"\a\a\bS\x01\v*\x01\x01\x01\x04\x02.\x02\x12\x05\x01\x01\x19\x01$\x01\x04\x01\x8c\x01\x01\v\x03\x01\x01\x06" +
+	"\x01\x06\x04\b\x01\x02\x04\x03\x03\t\x01\x01\x01\x1d\x01\b\x01\x06\x01D\x01:\b\x01\x02\x01\x01\x01\x01\x02\x02\x01" +
+	"\x01\x01\x05\x01\x02\x03\x03\x01\x04\x02\x03\x01\x01\x02\x01\x01\x03\x01\x14\x01\x01\x01\x01\x01\x04\x13\x01\r\x03p\x01\x01" +
+	"\x01\xfd\x01\x01\x12\v\b\x16\x01\vy\x01B\x03\x86\x04\x02p\x02T\x01\x16\x01\xfc\x01\x01\xc0\x04\x01\xd3\x01\x02" +
+	"\x12\x019\x01&\x02\x01\x01\n\x02\x05\x03\t\x01\xbb\x01\x02\t\x01\x0e\x018\x01v\x05\a\x01\x01\x01\b\x01\xbf" +
+	"\x01\x01\x01\x03\x02\x05\x04\x01\x02\x01\x06\x01\x01\x01$\x01\x11\x01\x03\x014\x01\x1c\x02\x19\x01\x0f\x04\x02\x01<\x03" +
+	"\x04\x01\x04\x02\x01\x01\r\x06\x06\x02\t\x01\x06\x01E\x013\x01*\x01\x1d\x01T\x01\x0e\x01\x02\x02\x02\a\n\x01" +
+	"\x03\x01\x03\x02\x93\x01\x01\x1d\x06\x01\x02\x01\x04\x01\x01\x01\x02\x01\x03\x01\x03\x01\x03\x01\x04\x03\x03\x01\x03\x01\x06\x01" +
+	"\a\x01\x03\x02\x01\x01\x05\x01\f\x01\x01\x01\x02\x01\b\x02\x01\x1e\x06\x01\x01\x12\x01\x02\x01w\x02>\x03\x04\x01\x06" +
+	"\x01\f\x1c\x01\x01\x03\x02\x02\x01\n\x04\a\x01C\x01\xc4\x01\x01\x16\a\n\x03\x03\x06\x14\x04\x10\x03w\x04\x01\x03" +
+	"\x1d\x03\x01\x01\x01\x01\x03\x01\x02\x03\x01\x0f\x04\x01\x02\x04\x01\x01\x02\x01\x01\x03\x04\x13\r\x02\x13\x10\x01\x03\x84\a" +
+	"\x04\b\x01\x06\x01\x01\x04\b\n\x01\x02`\x01\x02\x05\xa6\v\x01\xbe\v\x01\xdc\x01\x02\xda\x03\x01K\x01\n\x03\x05" +
+	"\x01'\x01\x04\x01&\x02.\x02\x04\x020\x05a\x01/\x01\x04\x01\f\x01p\x01(\x01\x03\x02\x15\x01\a\x02\n" +
+	"\x01\x18\x01+\x01\x0e\x04\xea\x05\x02\xb6\x05\x02\x8c\x01\x02\x89\x14\x02\xb7>\x01\xd8#\x01`\x01\xa5\x01\x03\xac\x01" +
+	"\x06\x9c-\x01\x87\x19\x02\x0f\x01\xcf\x01\x03:\x02p\x01\x01\x018\x02\xa9\x01\x01\x02\x01\x06\x02\x06\x04\x01\x01\x04" +
+	"\x04\x05\x02\x1e\x01\xf1\x14\x010\x02\x03\b\x14\x01\xe5\x14\x01\xe7\a\x01\x04\x01\x84\x02\x01\x14\t\xc0!\x02\xf3*" +
+	"\x02\x12\x01\a\x01\x06\x01\r\x01'\x01\x0e\x02&\x01@\x01\n\x01\x1b\x02\x05\x02\x01\x01a\x01\x14\x01\x12\x01\"" +
+	"\x018\x01\f\x01&\x01\x1c\x02J\x01\x03\x01\xa0\x05\x01\xd9\x01\x01\x12\x01\x01\x01\b\x01\x1b\x02\"\x01\x1c\x03\x06" +
+	"\x01\x16\x01\x14\x01\x81\x01\x01\x81\x01\x01\x01\x03m\x01\x04\x01\x02\x06\x05\x02\x0f\x02\t\x01\f\x04\r\x03\x04\x05\v" +
+	"\x01S\x01.\x01\x12\x01\x1a\x01\xe3\x0f\x01\r\x01\xc4\x02\x01\x01\x04\x02\x02\x02\x01\x02\x03\x01\x01\x04\x03\x01\x04\x01" +
+	"\x01\x01\x01\x03\x03\x01\x02\x03\x02 \x05\x13\x03\x01\x01H\x03\x14\b\x0f\x01\x02\x01 \x01\xdf\x01\x01\x01\x02\a\x01" +
+	"\\\x01\x04\x01\x11\x02\x13\x03\b\x04\x06\x01\x16\x03\x8c\x01\x04\x01\x01\x1d\x02\x01\x01\x03\x01\x03\x01\x01\x03\x02\x01\x02" +
+	"\x04\x01\x01\x01\x04\x03\x01\x02\x06\x01\x01\x02\x01\x02\x01\t\x01\r\x01\b\x06\x01\x01\x12\x01\xc8\x05\x01\x04\x01\x01\x01" +
+	"\x02\x02\x04\x01\b\x01\n\x01\x02\x01\x16\x04\xc2\f\x03\x18\x01\x04\v\x16\x02!\x02\x02\x01\b\x01\x05\x02\x0e\x01\x1f" +
+	"\x04m\t8\x01\x1c\x01\x02\a\r\x017\x02\x1e\x01+\x05\f\x01\t\x01\b\x01\v\x06\x05\x01$\x02\x04\x03\xac" +
+	"\r\x01\n\x01\x99\x01\x05\n\x02p\x02\xb1\x01\x04t\x01\xa8\x01\x06\x01\x01\x1d\x06\x01\x03\x02\x01\x01\x03\x01\x01\x01" +
+	"\x02\x01\x03\x01\x02\x02\x01\x01\x01\x01\a\x01\x05\x01\x01\x01\x01\x02\x01\x01\x02\x01\x05\x02\x01\x01\x02 \x06\x01\x01\x13" +
+	"\x01p\x03\x98\x02\x01\xf7\x03\x01\xa2\x05\x01\x02\x01-\x01\x18\x01\x95\x02\x01\x03\t\x02\x01\x1e\x05M\x01\x1d\x01\n" +
+	"\x01-\x01\x0e\x01\x0f\x03\x1c\x01\x04\x01\x03\x01\x0f\x01\x01\x01\x9d\x0e\x01\xa6\x02\x01\x84\x04\x02\xce\x04\x01\f\x02+" +
+	"\x01t\x01\x0e\x04c\x01\xaa\x01\x01\x85\x01\x01\xc5\x01\x01\xba\x01\x01\t\x01\xf4\x01\x01\x97\x01\x01\x02\x01\xfa\x02\x06" +
+	"\b\x02\x9e\x05\x02\xe0\x11\a\x01\x01\x01\x03\x01\x03\x04\x01\x01\x02\x02\x01\x03\x01\x02\x01\x01\x01\x01\x01\x01\x02\x01\t" +
+	"\x03\x02 \n\x93\x06\x03\n\x02\x02\x01\x05\x03\b\x01\x02\x01\x04\x03<\x01\x1f\x01\x01\x01\v\x01\r\x01\x03\x01\x03" +
+	"\x02\x03\x01\x19\x02\x0e\x03\b\x01!\x01\x06\x01\a\x01+\x01\x17\x02\x18\x06\n\x01\n\x01\x04\x01\x04\x01\n\x03\x13" +
+	"\x03\x17\x01\x0e\x01\x03\x02+\x04\x1c\x02\x0f\x01\x10\a\a\x01.\x02\x18\x03\x04\x01\x03\x01\xf9\x06\x02\b\x02\t\x01" +
+	"\x01\x01\x01\x01\x03\x05\x04\x04\x1c\x02A\x01\x19\x03#\x01r\x02/\x01\x04\x01\x14\x01\b\x010\x01\a\x01\x03\x02" +
+	"\x0e\x01\x02\t\x01\x02/\x010\x01\x01\x03\x01\x01\x05\x03\x1e\x01\b\x01\xa7\x01\x06\xd7\x01\x04r\x01I\x01\x81\x10" +
+	"\x02\x05\x01\x18\x012\x01\x12\x02\x01\x01\x14\x01\xce\x01\x03\r\x01\x10\x01\x10\x01\x01\x11\a\x03\x01\x04W\x01\x18\x01" +
+	"\x1c\x04\x01\x05\b\x01\x13\x01A\x02i\a\x03\x02\x02\x02\x02\x01\x01\x02\x06\x06\x04\x02\x01\x06\x03\x03\x02\x01\x06\x01" +
+	"\x18\t\x01\x02\xbe\x12\x012\x01p\x03\x89\x01\x03\x04\x02\x10\x03 \x01\x03\a\x03\x01j\x02R\x02\x85\x15\x01\n" +
+	"\x02\x04\x01\xbc\x03\x01\xd5\x02\x01\xc6\a\x01\x1c\x02\x1b\x01\x01\x01\x02\x06j\x01\x04\x05\r\x01\a\x01\x17\x03\x11\x04" +
+	"%\x01\x02\x01\xda\x01\x01\x04\x01\x02\x01h\x01\x06\x01C\x01t\x01\xda\x01\x01\x1a\x01\xbc\x01\x01&\x02\xa5\n\x02" +
+	"\xfb\x01\x06}\x06\xeb\x04\x01\x05\x01#\x01\xd1\x06\x02\xb5\x02\x01\x97\x01\x05\xb1\x18\x04\x0f\x01\xcd\x01\x01\x01\x01\x01" +
+	"\x01\x01\x06\x02\x02\x02\x01\x05\x02\x01\x01\x01\x02\x01\x01\x03\x03\x02\x02\x01\x01\x01\x01\x02\x01\xb1\x06\x01\x02\x01\b\x01" +
+	"\a\x01\"\x01$\x01\x18\x01-\x01+\x01\x8c\x01\x02\n\x01/\x03\b\x02j\x01\x1c\x06\a\x02\x1b\x01/\x01\x03" +
+	"\x02\x01\x01\xf8\x06\x06\b\x02\x04\x01\a\x01#\x02)\x03\x85\x01\x01?\x04Q\x01\x12\x01\x06\x03\xa0\x01\x02$\x02" +
+	"\x04\n\f\x05\xeb\b\x03\x05\x02\x04\x01\x06\x02\b\x05\x01\x04A\x01\x12\x01\x0f\x01\x02\x02M\x01`\x01D\x03\xc3" +
+	"\x02\x01\x05\x01[\x01\x01\x01\r\r_\x01\r\x01\xa8\x01\x02\t\x01h\x01\x05\x01\a\x05\x01\x03.\x01\x97\x01\r" +
+	"\x01\x05\x01\x03\x01\x02\x01\x06\x01\x06\x02\x05\x01\x04\x03\x03\x01\b\x01\x03\x01\a\x01\x06\x01\x01\x01\x02\x01\r\x01\x19" +
+	"\x01\x02\x02\x06\x02\x01\x03\x01\x1b\a\x13\x02\x02\x01)\x02\x0f\x01#\a\x01\x03\x12\x01e\x01f\x01\x02\x011\x01" +
+	"]\x03\x06\x02\b\x01\a\x01\x05\x01\x01\x02\x13\b\x11\x02\x13\x02\x01\x02!\f2\x06\x01\x01\x14\x02#\x01\x1e\x03" +
+	"\x03\x01\x02\x02\t\b\x01\x02\x04\x04\x03\x01 \x06\xe5\x01\x01\n\x01\xce\x02\x01\a\x01\xd4\x01\x01m\x01\x0e\x02\t" +
+	"\x01\x01\x01b\x01\x06\x02A\x02\x04\x06\x14\x02\x9e\x01\x01(\x06\x01\x01\xc5\x01\b\x01\x05\x01\x01\x01\x04\x01\x04\x01" +
+	"\x04\x01\x01\x01\x06\x01\b\x03\x01\x01\x04\x01\x03\x01\v\x01\x05\x02\x02\x01\x05\x01\x0f\x01\x04\x01\x01\x01\a \v\r" +
+	"\x01\x04\x02\x04\x01\xb5\x06\x01\x04\x017\x01\x0f\x01\xf0\x01\x05#\x01\x1e\x01L\x02-\x01$\x01t\x01\xc9\b\x04" +
+	"\xeb\x03\x01\x04\x01\x04\x01\x04\x01\x01\x01)\x02\xbc\x05\x05\x01\x01\xd2\x01\a\x98\x02\x01\xed\x03\x01\t\x01\x06\x01\x02" +
+	"\x04j\x01\xaf\x01\x01\x9b\x03\x02\x1b\x01\xb0\x01\x01~\x01\x0e\x01b\x01\x0f\x03\a\x02\x06\x01\x1c\x01\x03\t\x01\x01" +
+	"\x12\x12\x01\x01\a\x01\a\x01\x13\x01#\x03\x02\x02\r\x06\x01\x04U\x02\x03\x02\x05\x01\x04\x01\x02\x03\x01\x02\x03\x01" +
+	"\x96\x13\x012\x02\v\x02\x05\x01\b\x01\x01\x02X\x03\x01\x01\x0e\x01\x01\x01\xa1\x02\x01\b\x02\t\x02\x16\x011\x01" +
+	"s\x01\x01\x03\x06\x01\x05\x02#\x05\x01\x01\a\x01\x02\x01\x03\x01\x06\x01b\x02\x0e\x05\x01\x01^\x01\r\x01\x01\x03" +
+	"\x05\x01Y\x02\b\x01J\x01\x04\x04>\x02\x04\x02\x06\x01\x06\x01\x04\x02\x1e\x03\x01\x01.\x01y\x01\x1e\x03\x01\x01" +
+	"\x01\x01\x01\x02\x02\x01\x03\x02\x04\x01\x02\x04\x01\x01\x02\x01\x01\x04\x01\x05\x03\x01 \b\x8e\x04\x01\x95\x02\x01p\x02" +
+	"r\x01\xa0\x01\x01\xf3\x0e\x01\xc5\x04\x04\xc1\x01\x01\x01\x01\a\x02\x01\x01\x01\x03\xbb\x06\x01\x01\x01\b\x01\x01\x01\x02" +
+	"\x05\x04\x02\x01\x02\b\x11\x02\x01\x04\x02\n\x01\x03\x01\a\x02\x0f\x01\x03\x04\x03\x01\x0f\x02\x04\x01!\x03\x05\x01\x03" +
+	"\x02\x02\x01\x05\x01\x0e\x04\x12\x01\x18\x01\x04\x04\x04\x06\f\x01\r\x01\b\n\x05\x02\x01\x05\a\x01\"\x01\t\x01\x17" +
+	"\x06\x06\x02\x0e\x01\b\x02\x06\x01\n\x05\x04\x01\x04\x02\n\x03\x13\t\b\x04\a\x01\x04\x01\b\x01\n\x01.\x01\x1c" +
+	"\x01\x03\x05\a\x02\x05\x01\x01\x03\x0f\x1d\x01\x01\x06\f\x06\x01\x15\x02\x02\x01\r\x01\x1c\x04\x04\x01\x03\x02\x01\x04\x06" +
+	"\x01.\x01\n\x02H\x01\x86\x03\x01\xa6\x01\x02\x1c\x02\x11\x01\x99\x01\x03\x01\x01\x01\x01\x01\x03\x04\x01\x01\x03\x03\x02" +
+	"\x02\x01\x01\x01\x04\x01\x01\b\x01\x01\x02\x04\x04\x02\xfd\x03\x02<\x02\x14\x02!\x01\xc0\x03\x028\x01\x14\x01\xb0\x01" +
+	"\x03\x9c\x02\x010\x02\x14\x02$\x01n\x02\x06\x018\x02\x04\x01\x12\x01\x8e\x05\x012\x01\v\x01\xc5\x02\x01\x88\x01" +
+	"\x01\"\x01\x01\x01\x01\x01\b\x02\x01\x01\x04\x01\x01\x01\x02\x01 \x03\x95\x04\x01\x91\x03\x04\x12\x04\x01\x01\xf1\x02\x01" +
+	"\xc0\x01\a\x01\x03\x01\x02\x01\x05\x01\x03\x01\x04\x01\x03\x01\r\x01\x06\x02\x01\x01\x06\x01\x06\x01\x03\x01\x03\x01\x01\x03" +
+	"\x05\x01#\x03\x02\xa5\x01\x01\a\x01\x01\x02\xd5\x02\x01\xae\x02\x01~\x01\xdf\x04\x023\x02>\x02\xb6\x02\x03\r\x03" +
+	"\x81\x01\x01\x93\t\x02\x17\x02\x89\x03\x01\xc5\x01\x03\x03\x02\x04\x01'\x01\t\x01o\t\x01\x02\x04\x01\x17\x05X\x01" +
+	"\x10\x03\x01\x02O\x01\x12\x03A\x01U\x01S\x01\x06\x01\r\x02\x88\x01\x01E\x04d\x01\x02\x01\n\x02\x05\x03\x04" +
+	"\x02\x04\n\x01\x03b\x02g\x02\x10\x01@\x02\v\x01\xac\x02\x01\x01\x01\x03\x01\x05\x01\x02\x02\x05\x05\x03\x02 \x03" +
+	"\x03\x02;\x04\x18\x01\x04\x04\n\x01\f\x01\x05\x01\x0e\x01\a\x01\x02\x0e\x01\x04\t\x01\f\x01\f\x01\x04\x02\x12\x0e" +
+	"\"\x01\t\x01_\x02\x12\x01\x12\x01)\x01a\x01\x02\x04\x04\x03\v\x02\a\x06\x01\x06\x01\x01\x04\x01\x04\x014\x02" +
+	"\x88\x01\a\x01\x01\x03\x02\x01\x02\x02\x03\x01\x05\x03\x02\x01\x03\x02\b\x01\x01\x03\x03\x01\n\x03\x01\x05\x01\x13\x01\x01" +
+	"\x01\a\fp\x06\x01\x01=\x03p\x02\x0e\x01\b\x06\x01\x02\x05\x01\x04\x01\t\x01&\x01\x1b\x02p\x01\x01\x03\xe4" +
+	"\x01\x01\xb0\x01\x01\x06\x03\t\x01\x01\x01A\x01!\x01K\ab\x01\x12\x01\x06\x01\xa9\x02\x01\v\x01\x05\x01\xc0\x06" +
+	"\x02\x02\x01!\x01\b\x01&\x03\n\x02\t\x01\x02\x01\x02\x01\x10\x01\a\x0e\x01\x02\x1d\x01\x04\x01G\x01?\x02\x05" +
+	"\x05\x1d\x01\x0e\x01\b\x02\x01\x01\x05\x01;\x015\x01\x04\x01'\x01\a\x01\t\x06\x01\x01\f\x05\x06\x01\x01\x01\x8b" +
+	"\x01\x01\x01\x01\x1d\a\x02\x02\x01\x03\x01\x02\x01\x03\x01\x01\x01\x02\x01\x02\x01\x01\x02\x04\x01\x01\x01\x01\x01\x04\x01\x02" +
+	"\x03\x05\x01\v\x01\x02\x01\x01\x01\x03\x02\x01\x11\x03\r\x02u\x01\x17\x06\x01\x01h\x04\xa4\x01\x01e\x03\x16\x02\x1c" +
+	"\x03\xb0\x01\x01\xbd\x01\x01=\a\x04\a\x12\x01\x04\x01\f\x05\x04\x01\x03\x01\xe3\x03\x02\x01\x02\x01\x01\x01\x03\x03\x01" +
+	"\x01\x03\x04\x01\x03\x01\x01\x02\x03\x01\x01\x02\x03\x01 \x01)\x01\b\x02@\x01@\v\x88\x01\r\x18\x02\x1f\x01\x01" +
+	"\b\x03\x02\r\x04]\x01\a\x01\x01\x01\x05\x01\x05\x04\b\f\x02\x02\x04\x06\x16\x04\x01\x01\b\x01\x13\x13\xaa\x01\x02" +
+	"\x01\x01\x04\x02\x02\x03\x05\x04\x01\x01\x05\x01\x01\x02\x01\x01\x02\x04\x9e\x01\x01\x05\x10\x02\x01\a\x03Y\x01\xbe\x04\x03" +
+	"U\x01\r\x02\x0e\x06\x04\x01h\x03K\x03\x14\x013\x01\a\x15\x01\x06y\x01\x12\x01\xc6\x01\x02\x01\x01\x01\x03\x06" +
+	"\x02\x03\x02\x01\x01\x02\x01\x04\x01\x01\x03\x02\x01\x01\x02\b\x01\x18\x05\xef\x01\x01\xf4\x03\x01\r\x01\xad\x04\x01\x82\x03" +
+	"\x01\r\x01\x87\x05\x02\x02\x02\b\x05\x02\x02\x05\x02\x0e\b\x04\x01\x14\x01\x0e\x01\x0e\x01\x04\x02\x04\x02\x06\x03\x03\x01" +
+	"\x10\x01\x17\x01\b\x02\x06\x01\x12\x03\x04\x01\x14\x04\x04\v\x04\x04\x17\x01\n\x03\x06\x022\x01\x17\x01\x06\x04\x0e\x01" +
+	"\x0e\x01\x06\x01\x04\x01\x12\x02\x0f\x01\x01\x01\v\x03\a\x01\x10\x01\t\x03\x03\x02(\x03\x04\x01\x14\x03\x04\x06\x03\x03" +
+	"\f\x02\x01\x02\b\x03\x04\x01\x03\x10\x01\x014\x02\x1c\x02\x03\x02\a\x018\x02\xbb\x06\x02\x06\x01\x06\x01\x01\x03(" +
+	"\x01D\x01\xab\x01\x01\x04\x01\x83\x01\x01h\x02\b\x01\x02\x01\t\x05\x1b\x02\x01\x01\x11\x03&\x01\xd6\x04\x02\xaa\x0f" +
+	"\x01\x0f\x01\x02\x01-\x01\v\x01#\x03\x11\x01;\x01~\x01\x16\x01!\x01\x17\x01\x11\aE\x01\x05\x01\x02\x01\x01" +
+	"\x04\x03\x01\x01\x01\a\x01\a\x02\x01\x01!\x01\xc5\x01\x011\x01\xaf\f\x01\x05\x01\x01\x01\xcf\x01\x01\t\x01\x02\x01" +
+	"\xe5\x04\x01\t\x03$\x01\x03\x01\x01\x01\xfd\x01\x03\x02\x04\x02\x01\x02\x01f\x01/\x01\xa7\x0e\x03\x06\x01\x10\x01X" +
+	"\x01\x04\x01\t\x01\x05\x01\x01\x01\a\x04\x01\x04Y\x02\xd6\x03\x01\xb5\r\x02\x03\x02\xab\x01\x03/\x02 \x02J\x06" +
+	"\x13\x01%\x011\x02\r\x01\x04\x03\n\x02\b\x01\n\x01P\x06\x94\x02\x01\x03\x02\n\x01\x04\x01l\x05\xa3\x01\x01" +
+	"\t\x01\xa1\x02\x03\xe8\x06\x01p\x02\x01\x02\x0f\x04\x01\x01\x02\x02h\x03\x05\x02\x9c\x01\x01\t\x04\x06\x01\x96\x01\x01" +
+	"\xdf\x02\x01\xd6\x04\x01\x01\x01\r\x01\b\x01\xdd\x05\x01|\x02\xc7\x03\x04@\x02\xea\x19\x01\x05\x02\x03\x01\xcd\x06\x01" +
+	"\x04\x01\x01\x01B\x01\x0e\x01\xab\x02\x01G\x01G\x04Q\x01\xff\x06\x01\v\x01\x05\x02\xf0z\x03\x06\x01c\x03\x0e" +
+	"\x01`\x03\x02\x01\r\x02\x02\x01\xa6\x01\x01\x84.\x06\n\x04\x15\x01\x06\x01\t\x04\r\x01X\x016\x01\a\x03a" +
+	"\x05\x01\x01\x05\x01\x1a\x02\x01\x01\x03\x01\f\x01\b\x02\x13\x02\x13\x01[\x01\v\x01\x06\x04\x03\x02\x01\x044\x03 " +
+	"\x01\x8d\x03\x01\xa5\x02\x01\xc6\x01\x01\b\x01\xba\x01\x02\xb1\x03\x01\xed\x01\x01[\x01z\x03\xa5\x02\b\x01\x01\xc5\x01" +
+	"\x02\a\x01\x06\x01\x06\x02#\x02\x05\x02\x0e\x01\x01\x05\x01\t\x17\x01\x12\x01\x04\x02\v\x02\x11\x01\x06\x01\x06\x02\x06" +
+	"\a\x01\x02\x04\x02\x02\x01\x0e\x04\x06\x03\x01\x02\x01\x01\x0f\x02\x0e\x03\b\x02\f\x01\x16\x018\x01F\x03\x04\x03\x1a" +
+	"\x02\x02\x02\x0f\x01)\x01\a\x02%\x02\"\x01\x06\x04\x04\x01\x06\x02\x01\x01\x03\x02\x01\x03\x01\x01\x06\x05\x01\x01\x01" +
+	"\x01\x04\x01\x10\x01\a\x01!\x01\x04\x01\x17\x01m\r\x02\x03\x01\x03\x01\x02\x01\x02\x01\x01\x01\x02\x01\x06\x03\x01\x01" +
+	"\x04\x01\x03\x01\x05\x01\x02\x03\b\x01\x06\x01\x03\x01\x01\x01\x06\x02\x02\x16\x01\b\f\x01\x01s\x01r\x02\xaa\x04\x01" +
+	"\x0f\x01t\x01\x02\x01\a\x05U\x01\x05\x01\xc8\x03\x01\x0e\x01e\x01\x87\x03\x01\xfb\x02\x01\x05\x01\x05\x01h\x05\n" +
+	"\x01\x05\x02\x1d\x01\x10\n\r\x01\x90\x01\x01\b\x01\x16\x01\x04\x02\x01\x01\x03\t\x19\x02\x0f\x01b\x02\x03\x04\x01\x01" +
+	"\x06\x01\f\x01\n\x02\x10\x02\x02\x01\"\x03\x04\x02\x81\x01\x01\xcc\x06\x01\xef\x02\x01\xf0\x02\x01\v\x01\x03\x02 \x01" +
+	"\x15\x01[\x04\x01\x01\x19\x03\x82\x02\x01\x88\x01\x01\x01\x01\xa7\x01\x02\x01\x01S\x01\r\x01v\x01r\x01}\x01\xa2" +
+	"\x01\x01\x01\x01\xd3\x01\x02(\x01\x15\x02]\x01\x03\x02\x0e\x01\x01\x01\b\x01c\x02g\a\xda\x01\x01\xab\x01\x01\x9f" +
+	"\x01\x01Q\x01\xb1\x01\v\x06\x02\x02\x02\x06\x01\x16\x03\x06\x01\x14\x03\n\x01\f\x01L\x03'\x01\x05\x01\a\x05\x05" +
+	"\x01\x12\x01\x17\x01\x05\x01\x05\x02\x05\x02\b\x02>\x01c\x01\xb1\x01\x01\xa0\x02\x02\xf4\x01\x01\xbb\a\x01\n\x01c" +
+	"\x01\x04\x02\x0e\x01\x03\x01\xa7\x01\x02\x8c\x02\x02s\x01\b\x02\x1d\x01K\x04\x0f\x02\x01\x01\x89\x03\x01\x0e\x01\f\x04" +
+	"\x01\x02\x05\x01\x04\x02\xa0\x01\x02\x01\x01\x04\x01\x02\x01\x01\x02\x04\x01\x02\x03\x01\x01\x04\x01#\x06\n\x02\x02\x02i" +
+	"\x01U\x01\x16\x06\x01\x03\x03\x03\f\x01\xcf\x02\x05}\x02\x01\x02\x1c\x01\t\x01\x06\x01(\x01D\x01\t\x01\r\x01" +
+	"3\x01#\x01\x15\x01\x91\x01\x01\xac\x01\x01\v\x01\x10\x03\x82\x02\x01\x06\x01`\x01\x0e\x01\x01\x03k\x01\xb4\x04\x02" +
+	"\xfc\r\x02g\x01\xb7\x01\x01\x10\x02\xbd\x01\x02\x03\x02\x02\x03\x01\x01\x02\x01\x03\x02\x01\x01\x01\x02\x02\x03\x02\x01\x01" +
+	"\x02\x01\x01\x01\x01\x1a\x01\b\x02\x93\x06\x03\x04\x01\x06\x01\x02\b\x04\x01\x01\x01\b\x03\x06\x01 \x01\x06\x03\x03\x01" +
+	"\v\x01\x04\x02\x04\x01\t\x01\x03\x01\x1d\x02\a\x04\x04\x03\x04\x02\x06\x05\x03\x01\x03\x01\b\x01\b\x01\x06\x02\x03\x01" +
+	"\v\x02\x04\x01\x04\x02\x06\x01\x06\x01\x15\x03\x04\x01\x02\x04\a\x02\x16\x02\x15\x01\x13\x02\x04\x04\x06\x04\x12\x01\x04\x02" +
+	"\x06\x01\x03\x03\a\x01\x04\x01\x0e\x02\x13\a\b\x03\x04\x02\x02\x02\x01\x01\x02\x02\x06\x01\b\x01\x06\x022\x01\x14\x01" +
+	"\x02\x01\x02\x02\x03\x03\x01\x05\x06\x02\x01\x01\x04\x02\x01\x02\x04\x01\v\x18\a\x05\b\x01\x13\x01\x05\x02\x12\x01\x14\x02" +
+	"\a\x05\x01\x014\x01\n\x02\x8a\x02\x01\xc4\x01\x03\xec\x02\x03\x01\x06\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x04" +
+	"\x02\x01\x01\x01\x02\x04\x02\x01\x05\x03\x04\x02\x04\x02\x03\x01\x01\xad\x01\x01S\x02\b\x01T\x01\x15\x03\x1b\x02D\x01" +
+	"A\x01\x17\x01\x11\x034\x012\x010\x01\b\x06\x01\x01b\x03\xb4\x04\x01\xa1\x03\x01\x1c\x01\xab\x01\x01\x04\x01?" +
+	"\x01\x0e\x03\xba\x05\x02\x8e\x03\x02\b\x01,\x01\x03\x03\x03\x02\"\x02\x01\x01\r\x03\x01\x03\r\x06\x01\x02G\x01e" +
+	"\x01\x02\r\x10\x04\x03\x01#\x01\x0f\x03\x01\x03%\x02M\x06\x19\x02\b\x04\x01\x03\x14\x02)\x02O\x019\x02\x01" +
+	"\x03\x01\x03\x01\x01\x01\x01\x01\x02\x02\x01\x01\x01\x04\x01\x02\x03\x01\x01\x02\x01\x02\x03\x01\x01\x02\x03 \x05\x01\x01\x03" +
+	"\x01\xa9\f\b\x06\x02b\x04\b\x02\x06\x01\x01\x01\x04\x01\x04\x1b\x01\x01b\x01\x02\x01a\x01\x04\x01\x1c\x01%\x01" +
+	"\x04\x02\x03\x03\x02\x01B\x01\x04\x01\xf4\x01\x01\x02\x01\x03\x01\x03\x01\v\x01\x02\x01{\x05%\x01\x05\x02-\x01\x06" +
+	"\x05\xa3\x01\x01\x16\x01\x02\x01\x02\a\a\x01\x1c\av\x04\x11\x04\v\x01\x05\x02\x02\x01\xd2\r\x01\t\x01\x9b\x01\x01" +
+	"\x0e\x01\x9c\x02\x01\x80\t\x01v\x01\xdc\x05\x01_\x01\x9d\x01\x01\f\x01\x01\x02\v\f\x18\x01\x04\x01\x04\x01\n\x01" +
+	"\b\x03\x0f\x02\x16\x012\x02\x12\x01L\x02\x9f\x01\x01\x01\x01\x02\x01\a\x02\x01\x01\x05\x02\x01\x01\x02\x02\x01\x01 " +
+	"\x02\n\x01j\x02Z\x01!\x01K\x01Z\x02\b\x01\x01\x01I\x01\xe0\x06\x01\xfd\a\x01b\x01\x0f\x03l\x03\x05" +
+	"\x01\xa0\x01\x01\x02\x01\xa7\x01\x01\x05\x01\x98\x01\x01\x06\x01\x01\x02\x06\x04\x05\x02#\x05\x03\x02\a\x01\x03\x01\x02\x03" +
+	"\x04\x01\x02\x033\x02-\x04\x01\x01\b\x01\x01\x01\x02\x01\x02\t\t\x01[\x01\x06\x04\x02\x03\x01\x01\x05\x03\xa0\x01" +
+	"\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01C\x03\x04\x04\x04\x01\x06\x02\x06\x04\"\x01!\x01\f\x01\x02\x02\x97\x01\x03" +
+	"\x01\x03\x03\x01\x01\x02\x03\x02\x02\x02\x01\x01\x01\x01\x02\x03\x01\x03\x02\x01\x02\x01\x02\x01\x01\x01 \x03\x01\x01\f\x01" +
+	"#\x01\f\x01\x05\v\x18\x03\x1c\x01\x1e\x04\\\x01\x06\x01A\x01\x13\x01\r\x012\x01\"\x018\x04\x01\x01\n\x02" +
+	"\x02\x02\xb4\t\x01\x98\x02\x01\x92\r\x01\xeb\x01\x01\xab\x06\x01\x01\x01\x04\x02\x05\x02\x02\r\x04\x04\x01\x06\x01\x01\x03" +
+	"\x01\x04\n\x06\x04\a\x01\x06\x02\x03\x02\x04\x03\x04\x01\a\x01\x04\x01\x03\x05\x12\x05\n\x02\x1a\x01\t\x01\x02\x01\x01" +
+	"\x02\x04\x01\x04\x01\x04\x03\x03\x01\x03\x04\x03\x01\v\x01\x04\x01\n\x05\x03\x02\v\x05\x04\x03\x04\x04\x06\x01\x06\x01\x14" +
+	"\x02\x01\r\x05\x02\x01\x02\a\x02\x01\x01\x15\x04$\x03\x04\x02\n\x01\x0e\x02\x04\x03\n\x01\n\x03\x04\x03\x04\x02\n" +
+	"\b\x10\x01\x03\x14\x06\x02\x06\x04\x04\x01\x01\x05\x06\x01\x0e\x03\x03\x01'\x02\x04\x02\x1c\x03\x03\a\x01\x01\x06\x06\x05" +
+	"\x02\x03\x01\x06\x03\a#\x01\x01\x06\x0e\x03\x02\x18\x01\x05\x01\x0e\x04\x18\x04\x04\x03\x03\x06\x01\x04\x06\x028\x02\xba" +
+	"\x06\v\x01\x04\x01\x01\x01\x01\x03\x02\x01\x03\x01\r\x03\x02\x02\x01\x01\x02\x01\x02\x03\x03\x01\a\x01\x01\x02\b\x04\x04" +
+	"\x1c\x04)\x02\x85\x01\x01?\x01\x02\x03\x04\x01+\n\x04\x01\x1e\x01\x10\x02 \x01\x01\x01\x10\x01e\x01\v\x01\x05" +
+	"\x01\x0e\x02\t\x01\r\x03\x04\x02\xd0\t\x01\x12\x02\xaa\x01\x02\x86\n\x12\x01\x02\f\x01\xc4\x01\x01\r\x04\b\x01\v" +
+	"\x01\x03\x01\r\x02\x10\x02\a\x01-\x01,\x01\x01\x02\x04\x02\x05\x02\x02\x01\x03\x03\x04\x02\x02\x02\x02\x0e\x01\x03\x14" +
+	"\x02\a\x01>\x01\x88\x01\x03\x03\x01\x02\x01\x02\x01\x01\x01\x05\x02\x01\x01\x01\x01\x02\x01\x02\x01#\x03\x01\x01\xbc\x04" +
+	"\x01\xf2\a\x05\x06<`\x01\x05\f\x04\x0e\x01\x06\x04#\x01\a\x06\v\x02\xa1\x01c\x1a\x05\x12\x99\x01\x01\x0e\x01" +
+	"\x01\x04\x02\tD\x01\n\x05(\x010\x01\x96\x01\t\x02\x05\x01\x01\x01\x01\x01\x02\x02\x04\x01\x02\x04\x03\x02\a\x01" +
+	"\x01\x03\x02\x01\x01\x01\x01\"\x06\r\x01-\x02\x04\x02\x04\x01\f\x031\x02\x05\x02\x0f\x01\x1e\x01\x90\x01\x04\x15\b" +
+	"\x1c\x01\x02\f#$K\x02\x1d\x01\a\x01\x1b\x01\t\x05(\x06\xd3\x0e\x01\x17\b\x01\x01b\x01\xcd\x03\x02\x01\x01" +
+	"\xd2\x01\x01\xa5\f\x01*\x01\x8b\x01\x02\x17\x01\x06\x01\x1c\x01\x1b\x01\a\x02\x10\x01\x11\x01\v\x010\x01\x06\x01\x02" +
+	"\x02L\x01&\x03\r\x01c\n\x01\t\x01\x01\x01\x05\x01\x01\x01\x01\x01\x04\x01\x10\x01\x05\x02\x01\x01\x04\x01\x01\x02" +
+	"\x01\x01\x04\x02\x04\x01\v\x01\x13\x03\x04 \x02\x01\x01\xa3\f\x03\x01\x01\x05\x04\x05\x01\x01\x02\a\x01^\x01\x01\x04" +
+	"\b\x01\x0e\x01Z\x02\x02\x01\x0f\x01\x96\x01\x01\n\x02\a\x01\x96\x01\x02\x02\x01\x02\x01\x06\x01\x06\x02\x01\x03i\x01" +
+	"\x0f\x01\xd0\x01\x01\xdd\x04\x02\x01\x01\x03\x01\x01\x01\x06\x01\x02\x01\x01\x03\x01\x01\x04\x01\x03\x02 \x04\x7f\x02\x04\x03" +
+	"l\x05K\t\x04\x01P\x01P\x01\xbf\x02\x01M\x04\x14\x01\xe5\x01\x01\x1b\x01\x04\x03\x04\x01\x05\x013\x010\x01" +
+	"\x01\x02\x03\v\t\x02/\x03\xfc\b\x01n\x01\x96\x10\x02\x05\x02\t\x03\b\x02 \a\x01\x02\x8f\x06\x01\x04\x01\x01" +
+	"\x01\x02\x01\x01\x01\x05\n\x01\x01\x01\x05\x05\x02\x01\x02\x01\x01\x06\a\x02\x01\x04\a\x18\x03\b\x01\x06\x02\x0e\x01\x04" +
+	"\x02\x04\x03\x06\x02\x1b\x01\b\x01\x0f\x02\x03\x01\x03\x03\x03\x01\x03\x01\f\x01\n\x03\x0e\x05\x04\v\x04\x04\x06\x01\x1b" +
+	"\x05\x05\x10\x01\x0e\a\x05+\x03\x0f\x03\b\x03\x14\x03\x04\x05\x18\x02\x04\x02\n\x02\x10\x01\x03\x0f\b\x05\a\x01\x01" +
+	"\x02\v\x03\x04\x02\x06\x02\x03\x01+\x05\x18\x03\x04\x01\x03\x05\a\x03\x05\x02\x01\x03\b\x02\a\"\a\r\x1d\x01\x03" +
+	"\x01\x0e\x01\x04\x01\x14\x14\x04\x05\x03\a\x01\x03\x06\x028\x01\x12\x01\xe4\x01\x01>\x02\xe0\x01\x02`\x01\xc6\x01\f" +
+	"\x01\x01\x02\x01\x01\x02\x01\x04\x03\x03\x03\x02\x01\x01\x01\x02\x01\x03\x03\x01\x01\x01\x01\b\x03\x01\x02\x01\x02\x05\x01\x01" +
+	"\x1b\x06\xc1\x02\x02\x0f\x01\x01\x02\x90\x01\x01\x10\x01\x8f\x04\x01J\x01\x04\x02\xcf\x01\x01#\x02\x9e\x02\x01N\x02T" +
+	"\rT\x01\xea\x01\x01\xcc\x02\x03%\x01\r\x04\"\x01\x8e\x04\x01T\x01p\x01\x0f\x02\f\x01\x82\x03\x01\b\x01\xdb" +
+	"\x01\x01 \x01\x01\x01\x91\x01\x03\x04\x01\xaf\x06\x01$\v\a\x01\x01\x01\xb4\x02\x01\xfb\x01\x01\xd4\x06\x03A\x01\xef" +
+	"\x01\x01n\x028\x01\x16\x01\x93\x0e\x04\x02\x02N\x01\r\v\x01\x01\x84\x01\x01Y\x01\x02\x01\"\x01\xac\x01\x01\n" +
+	"\x01\xc1\x01\x01'\x02\x0f\x01\x1a\x01V\x01\r\x01\xac\x02\x01\b\x01\x01\x01\xdc\x02\x01\b\x01)\x01\x18\x01\x04\t" +
+	"\x04\x01\n\x02\b\x03\x01\x01\x12\x01\t\x01\x01\x02\t\x01\x18\x01\x16\x02\x02\x03\x1e\x01\x11\x01\x02\x01{\x01\xa4\x01" +
+	"\x01\x11\x03\t\x01\x14\x01\xa8\x01\x01\x9c\x04\x01\xc4\x02\x02W\x01b\x01\x01\x01\xb1\x01\x06\x13\x01\xb0\x02\x02\x03\x01" +
+	"\x10\x03\xbb\x06\x01\x05\x01\x19\x01\x12\x01=\x01\x0f\x04\b\a\x01\x05\x1c\x01\b\x01#\x01\x0e\x01\x04\x01\t\x01\x01" +
+	"\x01\x04\x01\x01\x01}\x02\x04\x020\x01\x11\x01+\x01\x04\x01\x06\x02\x12\x04\x10\x03\x06\x01\xc6\x01\x02\x05\x01\x01\x01" +
+	"\x02\x01\x03\x01\x03\x01\x01\x01\x03\x01\x01\x02\x05\x01-\x02\x0f\x03[\x01\v\x04\b\x04\x01\x02b\x01\x01\x02\xaa\x02" +
+	"\x01\n\x01\f\n\x01\x01\x01\x01\x11\x01\x97\x01\x02\x05\x02\t\x01\x04\x01\x01\x03\x03\x01 \x04A\x014\x01\x17\x03" +
+	"H\x01\x04\x01\b\x02\x01\x01\xe2\x02\x02z\x03\x1d\a\x05\x01\x01\x01\x01\x03\x01\t\x02\x01\x04\x05\x01\x01\x03\x02\x01" +
+	"\b\x03\x04 \x01\xef$\x01\x04\x01\x0f\x01p\x01\a\x01-\x02\x04\x01\x88\x01\x01\x14\x03\x16\x01\x82\x01\x01\x18\x01" +
+	"\a\x01\"\x01+\x02\b\x02\x9d\t\x01\x81\x01\x02\x11\x02e\x018\x01\x92\x15\x04\x01\x01|\x01T\x02\x12\x01." +
+	"\x03\a\x02\x01\x01U\x01\x04\x01\t\t\x05\x03\x06\x11\x02\x15\x01\x04\x1b\v\x01\x04\x0f\x01j\x01L\x03\x01\x05\x01" +
+	"\x03\x01\x03\x02\x02\x01\x02\x01\x02\x01\x02\x04\x02\x01\x02\x01\x05\x01\x01\x02\x01\x01\x01\x01\a\x03\x03\x02\x01\x1e\x02\xee" +
+	"\x12\x01\x1c\x02.\x01\xc0\x01\x01\b\x02\x01\x01\x1f\x01\x04\t*\x01d\x01\x14\x01\xae\"\x01f\x01\xd4\x01\x02g" +
+	"\x01\x9e\"\x01\xb10\x02L\x01A\x03`\x05\x01\x01\x17\x02\b\x01\x13,\x01\x02\x03\x02\x02\x038\x01b\x02\b" +
+	"\x03\x01\x04\xc1\x0f\x01\xc6\x01\x01\xec\x02\x02\f\x03\x03\x01\xbc\x01\x01\x06\a@\x03a\f\x0e\x04\b\x02\x01\x01Y" +
+	"\x01\x88\x01\x03\x02\x03\x05\x06\v\x02\x01\x01\x03\x02\xd3\f\x01\x02\fj\f\x04\x02\x02\x02_\x16\t\x02\b\x01\xa0" +
+	"\x01\x02\x0f\b\x99\x02\x02\x91\x01\x01&\x01.\x05\xaf\x01\x053\x04\r\x01|\x02 \x1f\xa1\x01\x01\xe7\r\x03\xc8" +
+	"\x04\x01\x87\v\x01\xb6\x01\x04\x01\x01\x01\x01\x01\x01\x01\x01\x01\x01\x02\x01\x01\x01\x02\x02\x02\x01\x02\x01\x04\x01\x01\x03" +
+	"\x03\x02\x1a\x01\x06\x06\xd2\x02\x04\x1f\x04p\x02\a\x02\x01\x05\a\x01\x01\x01L\a\x02\x01\xf0\x0e\x01\x80\a\x01\xf2" +
+	"\x03\x01\a\x02\xa7+\x03\r\x01\n\x01\t\x01\v\x03\x0e\x01p\x01\x06\x01\x16\x01\x1b\x01\x05\x01\x01\x01A\x01\x0e" +
+	"\x01\x0e\x02\x1c\x01!\x01y\x01\x15\x035\x01\x1c\x01\x03\x01\xe7\x01\x01\x92\x05\x01\f\x02\x02\x01\b\x01\xbd\r\x01" +
+	"\x93\f\x01\xc4\x1a\x02\xff(\x01p\x01\xcc\x17\x01\xdf\x02\x02\x04\x01\x8e\x02\x01 \x01\xf2&\x01}\x01\xe3\x01\x01" +
+	"\x80\a\x01\x81\x19\x05\xfa\x03\x01\a\x03\x01\x01\x1b\x01\x84\x17\x03\xdb\x15\x01p\x01\xe0\x0f\x02\x04\x01\t\x15\xe0\x04" +
+	"\x01\x91\x10\x04\x99\x03\x01\x04\x03\xc1\x01\x01\x12\x02#\x01\xce\x01\x06\xa5)\x01\x01\x02\x01\x02\b\x01\x02\a\x04\x02" +
+	"\x01\x01\x01\x02\a\x02\x02\x01\x04\x01\n\x01\x03\x01\x03\x01\b\x01\a\x01\x04\x01\x03\x03\x12\n\x04\x01\x06\x02\x1a\x01" +
+	"\x10\x05\b\x01\x06\x05\x03\x01\x03\x01\b\x01\x0e\x02\x0e\x01\x04\x03\x14\x01\x11\x05\x05\x01\x01\x01\a\x01+\x01\x17\x03" +
+	"\x14\x03\b\x02\x06\x01\x06\x01\b\x01\x04\x02\n\x02\x13\x06\b\x01\x06\x01\x03\x02\x06\x02\x0e\x02\x06\x01\x1c\x01\f\x02" +
+	"\x18\x01\x04\x01\x03\x02\x04\x02\x03\x01\x05\x01\x01\x01\b\x01\a\x0e\x01\x01\x06\x05\x03\x01\x18\x04\x02\x01\x1b\x01\x0e\x05" +
+	"\x04\x04\x03\x04\x01\x01>\x01\xa1\x01\x01\x80\x02\x01\xd3\x01\x01\xc6\x01\x05\x01\x01\x03\x01\x01\x01\x02\x01\x01\x03\x04\x02" +
+	"\x06\x04\x01\x03\x03\x02\x04\x03\x01\x01\x1b\x04\xe1\x03\x01\x8e\x15\x01\xd9\x02\x01h\x01%\x01\xcc\x01\x01\b\x01'\x01" +
+	"\xa4\x15\x03\xf8\x14\x01\xf1O\x01\x81\x03\x01\xb5\x0f\x01K\x01\a\x01\a\f\x04\x03\x02\x01\n\x04\x05\x01\x03\x01\a" +
+	"\x02\x02\x02\f\x02\x06\x01\x02\x02\x01\x03\t\x04(\x02\x0e\x03\t\x01\x04\x02\n\x02\x03\x01g\x01\x1c\t\x03\x14\x01" +
+	"\x01\x02\x01\x03\x01\x02\x05\x03\x02\a\x01\r\x05\f\x03\x04\x01\x01_\n\x01\x01\x02\x04\x01\x06\v\x02\x06-\x01-" +
+	"\x0e\b\x01\x02\x01\x03\x01\x1d\x02\b\x1f\x15\x03\a\v\x15\x01%\x01q\x01\x01\x05\x01\t\x02\b\x01\x06\x03\x02\x01" +
+	"\x02\x01\a\x01\x02\x01\x04\x02\x03\x01\x02\x01\x02\x01\b\x01\x03\x02\x02\x01\a\x02\x02*\x01\x01\x02\x02\x02\x06\x03]" +
+	"\x02\x02\x02\x02\b\x01\a\x01\x02\x02\x01\x03\x02\x02\x04\x05\x02\x01\x02\a\x12c\x02\x04\x01C\x01\x14\x01G\x01\a" +
+	"\x04\x03\x01\x02\x01\x04\x02=\x03\x04\x03\x04\x01\x06\x02W\x03\x02\x15w\x10a\x02\x01\x01\x01\t\x04\x02\x02\x01\x01" +
+	"\x03i\x01\x04\x02C\x03\x04\x04\x04\x02\x06\x01\x1f\x01\x02\x01\x02\x01C\x04\x04\x01\x06\x01\x06\x02B\x01\x03\x02\x06" +
+	"\x04\x01\t\x01\x12\x01\x01\x03\x01\x03\a\x01\x01?\x01\x04\x03\x04\x03\x06\x01\n\x01K\x02\x02\x01\xd4\x01\x01\x03\x02" +
+	"\x04\x01\x05\x02\x03\x02\x04\x03X\x11\x02\x03\x01\x06\x01\x03\x02\x02\x05\x04\x04\x01\x02\x01\x01\x05\x01\x02\x02\x02]\x01" +
+	"\x01\x04\x01\x01\v\x01\x02\x02\x01\t\x9c\x01\x01\a\x02\x01\x03\x06\x02\x02\x0e\x03\x01\x01\x04\x01\x019\x01\b\x03\x06" +
+	"\x02\x06\x01A\x01\x10\x01\x02\x01\xcf\x01\x01\x0f\x032\x01N\x01b\x03S\x01\x18\x03\x01\x02\x0f\x01\x01\x05\x01\x02" +
+	"\x02\x04\b\x01\x05\x033\x02\"\x033\x01\x04\x02E\f\x04\x042\x01\r\x02\x04\x04\r\x03n\x04\x06\x01\x03\x06" +
+	"\x01\x05\x05\x04\x02\x02]\x02\x04\a\a\x05\v\x01^\x03\a\x02\x02\a\x04\a\x01\x01\x01\x02\x02\x04\x02\x01=\x02" +
+	"\b\x01\x06\x01Q\x01\x03\x01\x03.\x03\b\x03\x01=\x01\x04\x02\n\fQ\x01\x02\x01\x06\x02\xd5\x01\x01\r\x03h" +
+	"\x02\x06\x06^\x01\x10\x03\x04\x03\x96\x01\x03\x05\x03\x06\x05\x05\x01\x02\t\x05\x01\x01\x019\x03\x04\x06\x04\x03\x06\x03" +
+	"F\x01\xe3\x01\x15\b\x01\x02\x05\x02\x05\x02\x01\x01\a\x01\x02\x02\x17[\x06\x03\x01\x0e!\t\x12\\\x04\x06\n\x06" +
+	"\x1f\xa1\x01\x04\x01\x02\x01\x01\x02\x03\x04\x01\x01\x06\x02\b\x9e\x01\x01\x01\x01\x04\x01\x01\x03m\x01\xb1\x01\x01\b\x01" +
+	"N\x02\xb6\x01\x02\x03\x02\x0f\x04\x01\x02\x0f\x05\x01\t\x02\x03\x056\x01\x01Y\x02\n\x01\x15\x01\x04\x01\x0f!\x01" +
+	"\x02\x13\b\b,\x01\x12\xa9\x01\x05\x02\x02\x01\x02\x02\x04\x02\x05\x03\x01\x02\x02\x04\x01\x02\x02\x01\x04\x01\x01\x02\x04" +
+	"\x1b\x02\x18\x02\x05\x02\x9d\x04\x01\x03\x01\x06\x03\x06\x05\xc2\x02\x01w\x01\x02\x02\x83\x01\x01\n\t\x97\x03\x01\x02\x01" +
+	"\n\x01\x04\x06\x01\x04\x01\x05\x03\x01\x02\x01\x01\x01W\x01\x05\x01\b\x01\x05\x02\x01\x03^\x02\x04\x01\x01\x02\x04\b" +
+	"\x05\x01\x01\x01\x02\x01\x01\x06\x02\x05\x93\x01\x02\x03\x01\n\n\x01\x05\x03\x01\x04\x03\xf5\x02\x01\x01\x03\a\x02\x01\x01" +
+	"\x02\n\x02\x01\x02\a\x01\x01\x06\x06X\x03\x04\x01\b\x02\x01\b\x06\x02\a\n\x01\x05W\x01\x04\x03\x05\x02\x02\a" +
+	"\xa6\x01\x01\x01\x02\x01\x02\x04\a\x01\x02\x01\x01\x01\x02R\x02\t\x06;\x02\x10\x05p\x05\b\x17b\x03\a\r]" +
+	"\x02\x02\x02\x06\x01\t\x01\x02\x02\x02\x06\x01\x06\x01\x02[\x01\r\x01\x9a\x01\x02\a\x01\x05\x02\x04\x05\x01\x01\x01\x1c" +
+	"\x02\x01\x01\x06\x04\f\x81\x03\x01\x12\x02\t\x03\f\x04\x02\x01U\a\x15l8\x01\x01\x01X\x047\x02\x02\f\a" +
+	"\x02\x0f\x10\x01\x01%\x01\a\x01/\x01\t\x01\v\x14%\x05\x1b\x02\v\a\x02\t\r\x05%\t=\x01`\x01\x06" +
+	"\x01\t\x06\x01\x03\x01\x01\x01\x01\\\x01\x04\x01\x02\x01\b\a\x03\x06\x02\x01A\x01\b\x01\x16\x04\x02\x01\t\x01\x05" +
+	"\x01B\x05\x04\x02X\x02\x03\x02\x03\x01\x01\x01\x02\x01\x01\x04\x04\x05>\x01\x04\v\x04\x02\x06\x06\x06\x01A\x02\x10" +
+	"\x04\x02\x02\xfd\x05\x01\x04\x03\x04\x05\xd9\x01\x06\f\x02\x06\x02]\x05\x02\x01\x01\x02\x01\x01\x02\x06\x03\x01\x03\x05\x03" +
+	"\x03\x01\x02\x02\x05\x01\x01c\n\f\x01\xaa\x01\x01\xf9\x02\x01\x03\x03\x04\x03\a\x01\x02\x01\x01\x01\x02\b'\x01\a" +
+	"\x01\x03\x04'\x03\x02\x04\x02\x0e\a\x03\x02\x03\x02\x02\x02\x01\x02\x02\x02\x02\x02\x01=\n\x04\x1f\x04\x01\x06\a\x03" +
+	"\x02\x0e\x01\x03\x03\x06\x01\x01\x06\x05\x03\x01\x01E\x01\n\x01.\x01\x1a\x01\x03\x01\x06\x01\x01\n\x02\x02\x03\x01\x03" +
+	"\x02\x06\f\x01\x02\b\x01\x04\x01\x03\x02\x03\x01\x1f\x01@\x05\x04\x02\x04\x05\x06\x01\x03\n\x03\x02\t\x01\x01\f\x01" +
+	"\x03\x02\x04\b\x01\x02\x01\x02\x055\x01<\x01h\t\x01\x01\a\x02i\x01\x01\x01\x06\x01BA\x04\xe2\x01\x04\x1e" +
+	"\x06\f\x03\x06\x03\x01\x0e\x01\x06\b\x05\x02\xa2\x01\x02\x03\x03\x01\x01\a\x03\x04`\x01\x039\x05\b\x01\x06\x01\x06" +
+	"\x05L\x01\x01\x01\x04\x01/\x01\x8c\x04\x01\x03\t\x02\x01\x02\x03\x01\x01\f\x012\x06\x01\x01\v\x05>\x01\x10\x01" +
+	"\x06\x05\x01\x03\x85\x02\x01p\x01\x05\x01\x04\v\x06\x01]\x01\x0e\x01\x9e\x01\x01\x0e\x01\x9d\x01\x01\xdd\x01\x02\x03\x01" +
+	"\x02\x03\x04\x03\x01/\x01\x05\x02\x01\x03\x06\\\x03\a\a\x02\x03\x04\f\t\x11:\v\x04\r\x04\x18\x06\n\n\x01" +
+	"\a\x01\x02\x05\x06\x05\x02\x01\x05\x02\x01\x1e\x06\x01\x9b\x01\x04\x01\x04\x04\n\x03\bD\x01\xb0\t\x05\x12\x01\x01\x01" +
+	"\x99\x02\x17\xe5\x03\x01\xaa\x05\x01%\x01\b\x01*\x01\x16\x01\x18\x01\n\x01*\x01\x0e\x01\x1b\x02\x06\x02\a\x01^" +
+	"\x01\t\x02\x19\x01\x15\x01w\x01\x15\a\a\x01J\x01\x04\x01>\x01G\x02\x02\x02\x04\x01\x01\x03\x04\x03\x02\x01\b" +
+	"\x03\x02\x01\t\x01\x06\x01\x02\x01\x05\x01\x12\x01\f\x01\x0f\x01\x19\x01\n\x018\x02*\x01\x0e\x02 \x02\b\x03n" +
+	"\x03'\x02\n\x01\xd3\x01\x01\x03\x04\x01\x014\x01\n\x01\x81\x02\x01\x9d\x01\x013\x01\xa3\x01\x01\xeb\x03\x01\xd6\x02" +
+	"\x01\xca\x02\x01G\x02\xd1\x01\x01\a\x01\xf6\x03\x01\xa0\x02\x01\xc2\a\x01\xa0\x03\x01\x0e\x01\xa7\x01\x02\x90\x03\x01\x8c" +
+	"\x06\x01\xfe\x02\x02\v\x02\x84\a\x02\xd2\x01\x01\x06\x01\x04\x01\x90\x0f\x01\xfc\b\x03^\x04\xe6\a\x01\x01\x01\xfd\x01" +
+	"\x01\xbe\x05\x02\x14\x01\xda\x10\x01\x90\x04\x01\x03\x01\x03\x01\x01\x01\x05\x01\x01\x01\t\x01\x86\x06\x01\x06\x01\xe4\x03\x01" +
+	"\xdc\x03\x02\x81\x06\x01I\x01\x04\x01\x04\x01\x10\x01\x17\x01\xaf\v\x01\x9a\x02\x01\x8f\t\x04\x02-\x05\x04\x01\x02\a" +
+	"\x04\x06\x05\x04\x01\f\x12\x04\x01\x04\x01\a\x01\x04\x01\x03\x03\x03\x01\x0f\x02\n\x03\x03\x01\x03\x01\x14\x01\t\x03\x02" +
+	"\x01\x05\x01\b\x01\x06\b\x03\x01\x13\x01\x06\x01\x03\x02\v\x04\x04\x06\x04\x01\x06\x04\x13\x01\b\x06\x05\x01\x01\x15\a" +
+	"\x10\x16\x02$\x01\x04\x02\x04\x03\x06\x03\x0e\x02\x0e\x01\x03\x02\x03\x01\x04\x05\b\x02\x04\x01\x06\x05\x13\x02\x02\x01\x04" +
+	"\x02\x02\x04\x04\a\v\x01\x11\x02\x03\x01$\x01\x04\x04\x04\x01\x14\x02\x02\x03\x02\x01\x03\t\x01\x01\x06\x04\x01\x01\x04" +
+	"\x02\x01\x01\f\x01\x030\a\b\x1b\x01\x02\x01-\f\x03\x14\x01\x05\x06\x02\x03\x015\x06*\x01\x01\x01\x01\x01\x01" +
+	"\x01\x01\x01\x01\x02\x02\x02\x01\x05\x04\x03\x01\x01\x01\x01\x04\x01\x01\b\x03\x02\x02\x01\x03\x10\x01\x03\x05\x02\x02\x02\x01" +
+	"\x02\x06\x01\b\x02\x02\x01\xdd\x05\x02\x01\x01\x0f\x02\x01\x02\x01\x02\x03\x01\x04\x11\x01\x03"
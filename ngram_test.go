@@ -0,0 +1,143 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "regenerate data files built by tests")
+
+const englishNgramDataFile = "ngram_table.go"
+
+func renderNgramData(data []byte) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintln(&b, "// Code generated by go test -run TestEnglishNgramData -update. DO NOT EDIT.")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "package main")
+	fmt.Fprintln(&b)
+	fmt.Fprintln(&b, "// englishNgramData is the quadgram model trained from data/corpus.txt, as")
+	fmt.Fprintln(&b, "// written by ngramModel.save.")
+	fmt.Fprintln(&b, `const englishNgramData = "" +`)
+	for i := 0; i < len(data); i += 32 {
+		sep := " +"
+		if i+32 >= len(data) {
+			sep = ""
+		}
+		fmt.Fprintf(&b, "%q%s\n", data[i:min(i+32, len(data))], sep)
+	}
+	return format.Source(b.Bytes())
+}
+
+func TestEnglishNgramData(t *testing.T) {
+	want, err := trainNgramModelFile("data/corpus.txt", 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := want.save(&buf); err != nil {
+		t.Fatal(err)
+	}
+	src, err := renderNgramData(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *update {
+		if err := ioutil.WriteFile(englishNgramDataFile, src, 0644); err != nil {
+			t.Fatal(err)
+		}
+		return
+	}
+	if englishNgramData != buf.String() {
+		t.Fatalf("%s is stale, run go test -run TestEnglishNgramData -update", englishNgramDataFile)
+	}
+	got := englishNgrams()
+	if got.n != want.n || got.floor != want.floor || len(got.counts) != len(want.counts) {
+		t.Fatalf("loaded model differs from trained one")
+	}
+	for i := range want.logp {
+		if got.logp[i] != want.logp[i] {
+			t.Fatalf("logp[%d] = %v, want %v", i, got.logp[i], want.logp[i])
+		}
+	}
+}
+
+func TestNgramModelOrders(t *testing.T) {
+	corpus, err := ioutil.ReadFile("data/corpus.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	text := []byte("Now that the party is jumping")
+	for n := 2; n <= 4; n++ {
+		m, err := trainNgramModel(corpus, n)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var buf bytes.Buffer
+		if err := m.save(&buf); err != nil {
+			t.Fatal(err)
+		}
+		m2, err := readNgramModel(&buf)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if m.score(text) != m2.score(text) {
+			t.Errorf("n = %d: score changed by saving", n)
+		}
+		noise, _ := repeatingKeyXor(text, []byte{0x5a})
+		if m.score(text) >= m.score(noise) {
+			t.Errorf("n = %d: score(text) = %v, score(noise) = %v", n, m.score(text), m.score(noise))
+		}
+	}
+	for _, n := range []int{0, 1, 5} {
+		if _, err := trainNgramModel(corpus, n); !errors.Is(err, ErrInvalidNgramOrder) {
+			t.Errorf("n = %d: got '%v', want '%v'", n, err, ErrInvalidNgramOrder)
+		}
+	}
+	if _, err := trainNgramModel([]byte("a\x00b"), 2); !errors.Is(err, ErrEmptyCorpus) {
+		t.Errorf("got '%v', want '%v'", err, ErrEmptyCorpus)
+	}
+}
+
+func TestReadNgramModelInvalid(t *testing.T) {
+	m, err := trainNgramModel([]byte("the cat sat on the mat"), 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	m.save(&buf)
+	valid := buf.Bytes()
+	for _, input := range [][]byte{
+		nil,
+		[]byte("ngrm"),
+		[]byte("NGRM\x03\x01\x00\x01"),
+		[]byte("ngrm\x05\x01\x00\x01"),
+		valid[:len(valid)-1],
+		append(append([]byte{}, valid...), 0),
+	} {
+		if _, err := readNgramModel(bytes.NewReader(input)); !errors.Is(err, ErrInvalidNgramModel) {
+			t.Errorf("readNgramModel(%q) = '%v', want '%v'", input, err, ErrInvalidNgramModel)
+		}
+	}
+}
+
+func TestNgramModelCracks(t *testing.T) {
+	m := englishNgrams()
+	input := []hex{"1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736"}
+	got, err := bestHexLine(input, m)
+	if err != nil || got != "Cooking MC's like a pound of bacon" {
+		t.Errorf("bestHexLine = %q, '%v'", got, err)
+	}
+	hexs, err := readHexLines("data/4.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err = bestHexLine(hexs, m)
+	if err != nil || got != "Now that the party is jumping\n" {
+		t.Errorf("bestHexLine = %q, '%v'", got, err)
+	}
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"context"
+	"runtime"
+	"sync"
+)
+
+// parallelFor calls fn for every i from 0 to n on at most workers goroutines,
+// or one per CPU if workers <= 0. Results are deterministic as long as fn(i)
+// only writes to state owned by i. Once ctx is done no more calls are started
+// and its error is returned.
+func parallelFor(ctx context.Context, n, workers int, fn func(i int)) error {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+	if workers > n {
+		workers = n
+	}
+	next := make(chan int)
+	var wg sync.WaitGroup
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range next {
+				fn(i)
+			}
+		}()
+	}
+	var err error
+loop:
+	for i := 0; i < n; i++ {
+		select {
+		case <-ctx.Done():
+			err = ctx.Err()
+			break loop
+		case next <- i:
+		}
+	}
+	close(next)
+	wg.Wait()
+	if err == nil {
+		err = ctx.Err()
+	}
+	return err
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+)
+
+func TestParallelFor(t *testing.T) {
+	for _, workers := range []int{0, 1, 3, 100} {
+		calls := make([]int32, 50)
+		err := parallelFor(context.Background(), len(calls), workers, func(i int) {
+			atomic.AddInt32(&calls[i], 1)
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, n := range calls {
+			if n != 1 {
+				t.Errorf("workers = %d: fn(%d) called %d times", workers, i, n)
+			}
+		}
+	}
+	if err := parallelFor(context.Background(), 0, 4, func(int) { t.Error("called") }); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestParallelForCancel(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+	err := parallelFor(ctx, 1000, 2, func(i int) {
+		if atomic.AddInt32(&calls, 1) == 10 {
+			cancel()
+		}
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("got '%v', want '%v'", err, context.Canceled)
+	}
+	if calls >= 1000 {
+		t.Errorf("all %d calls made after cancel", calls)
+	}
+	input, _, err := loadFile("data/6.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := crackRepeatingKeyXor(ctx, input, l1Scorer{}, xorOptions{}); !errors.Is(err, context.Canceled) {
+		t.Errorf("crackRepeatingKeyXor: got '%v', want '%v'", err, context.Canceled)
+	}
+}
+
+func TestCrackRepeatingKeyXorDeterministic(t *testing.T) {
+	input, _, err := loadFile("data/6.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	opts := xorOptions{candidates: 8, workers: 1}
+	want, err := crackRepeatingKeyXor(context.Background(), input, logLikelihoodScorer{}, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, workers := range []int{2, 7, 64} {
+		opts.workers = workers
+		got, err := crackRepeatingKeyXor(context.Background(), input, logLikelihoodScorer{}, opts)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(got.ranked) != len(want.ranked) {
+			t.Fatalf("workers = %d: %d keys ranked, want %d", workers, len(got.ranked), len(want.ranked))
+		}
+		for i := range want.ranked {
+			if !bytes.Equal(got.ranked[i].key, want.ranked[i].key) || got.ranked[i].score != want.ranked[i].score {
+				t.Errorf("workers = %d: key %d is %q, want %q", workers, i, got.ranked[i].key, want.ranked[i].key)
+			}
+		}
+	}
+}
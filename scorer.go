@@ -0,0 +1,212 @@
+package main
+
+import "math"
+
+// scorer rates how much bytes look like the expected plaintext. For every
+// scorer lower is better, so crackers can pick the minimum whatever scorer
+// they are given. Scorers must be safe to call from several goroutines at once.
+type scorer interface {
+	score(bytes []byte) float32
+}
+
+// histogramScorer is a scorer that only looks at how many times each byte
+// occurs, not where. XOR with a constant key only moves those counts around,
+// so crackers can count the bytes of a ciphertext once and score every
+// single-byte key from that.
+type histogramScorer interface {
+	scorer
+	// scoreHistogram scores the bytes counted in h, each XORed with key.
+	scoreHistogram(h *histogram, key byte) float32
+}
+
+// histogram counts the distinct bytes of some input. Keeping only the bytes
+// that occur makes it cheap to go through for short inputs.
+type histogram struct {
+	n      int    // Number of bytes counted
+	values []byte // Distinct bytes, in increasing order
+	counts []int  // Times each of values occurs
+}
+
+func byteHistogram(bytes []byte) *histogram {
+	var counts [256]int
+	for _, c := range bytes {
+		counts[c]++
+	}
+	h := &histogram{n: len(bytes)}
+	for c, count := range counts {
+		if count > 0 {
+			h.values = append(h.values, byte(c))
+			h.counts = append(h.counts, count)
+		}
+	}
+	return h
+}
+
+// l1Scorer is the L1 distance between the letter frequencies of the input and
+// letterFreq. Only lowercase letters are counted.
+type l1Scorer struct{}
+
+func (l1Scorer) score(bytes []byte) float32 {
+	return calcScore(bytes)
+}
+
+func (l1Scorer) scoreHistogram(h *histogram, key byte) float32 {
+	return calcScoreHistogram(h, key)
+}
+
+// chiSquaredScorer is Pearson's chi-squared statistic for the input against
+// the same model of English as logLikelihoodScorer, with the bytes counted in
+// bins for each letter, case folded, spaces, other printable bytes and the
+// rest.
+type chiSquaredScorer struct{}
+
+func (s chiSquaredScorer) score(bytes []byte) float32 {
+	return s.scoreHistogram(byteHistogram(bytes), 0)
+}
+
+func (chiSquaredScorer) scoreHistogram(h *histogram, key byte) float32 {
+	if h.n == 0 {
+		return 0
+	}
+	const space, printable, other = 26, 27, 28
+	var counts [29]int
+	for i, count := range h.counts {
+		c := h.values[i] ^ key
+		switch l := foldLetter(c); {
+		case l != 0:
+			counts[l-'a'] += count
+		case c == ' ':
+			counts[space] += count
+		case isPrintable(c):
+			counts[printable] += count
+		default:
+			counts[other] += count
+		}
+	}
+	n := float32(h.n)
+	var chi2 float32
+	for i, observed := range counts {
+		var p float32
+		switch i {
+		case space:
+			p = llSpace
+		case printable:
+			p = llPrintable
+		case other:
+			p = llOther
+		default:
+			p = llLetters * letterFreqs[i]
+		}
+		d := float32(observed) - p*n
+		chi2 += d * d / (p * n)
+	}
+	return chi2 / n
+}
+
+// logLikelihoodScorer is the negated mean log probability of the input under
+// a simple model of English text: letters from letterFreq, spaces, other
+// printable characters and, rarely, anything else.
+type logLikelihoodScorer struct{}
+
+// Share of each class of bytes in the model used by logLikelihoodScorer.
+const (
+	llLetters   = 0.78
+	llSpace     = 0.17
+	llPrintable = 0.0499 // Split over the other printable bytes and \t\n\r
+	llOther     = 0.0001 // Split over everything else
+)
+
+var llLogProbs = func() [256]float32 {
+	var p [256]float32
+	var printable, other int
+	for c := 0; c < 256; c++ {
+		switch {
+		case foldLetter(byte(c)) != 0 || c == ' ':
+		case isPrintable(byte(c)):
+			printable++
+		default:
+			other++
+		}
+	}
+	for c := 0; c < 256; c++ {
+		var x float64
+		switch l := foldLetter(byte(c)); {
+		case l != 0:
+			// Lowercase is assumed to be ten times as common as uppercase.
+			x = llLetters * float64(letterFreq[l])
+			if byte(c) == l {
+				x *= 10.0 / 11
+			} else {
+				x *= 1.0 / 11
+			}
+		case c == ' ':
+			x = llSpace
+		case isPrintable(byte(c)):
+			x = llPrintable / float64(printable)
+		default:
+			x = llOther / float64(other)
+		}
+		p[c] = float32(math.Log(x))
+	}
+	return p
+}()
+
+func (s logLikelihoodScorer) score(bytes []byte) float32 {
+	return s.scoreHistogram(byteHistogram(bytes), 0)
+}
+
+func (logLikelihoodScorer) scoreHistogram(h *histogram, key byte) float32 {
+	return meanLogProb(h, key, &llLogProbs)
+}
+
+// meanLogProb is the negated mean log probability of the bytes counted in h,
+// each XORed with key.
+func meanLogProb(h *histogram, key byte, logp *[256]float32) float32 {
+	if h.n == 0 {
+		return 0
+	}
+	var sum float32
+	for i, count := range h.counts {
+		sum += float32(count) * logp[h.values[i]^key]
+	}
+	return -sum / float32(h.n)
+}
+
+// printableScorer is the share of bytes that aren't printable ASCII or common
+// whitespace. It suits plaintexts that are text but not prose, like JSON or
+// source code, and is a coarse filter otherwise.
+type printableScorer struct{}
+
+func (s printableScorer) score(bytes []byte) float32 {
+	return s.scoreHistogram(byteHistogram(bytes), 0)
+}
+
+func (printableScorer) scoreHistogram(h *histogram, key byte) float32 {
+	if h.n == 0 {
+		return 0
+	}
+	bad := 0
+	for i, count := range h.counts {
+		if !isPrintable(h.values[i] ^ key) {
+			bad += count
+		}
+	}
+	return float32(bad) / float32(h.n)
+}
+
+func isPrintable(c byte) bool {
+	return c >= 0x20 && c < 0x7f || c == '\t' || c == '\n' || c == '\r'
+}
+
+// foldLetter returns the lowercase version of an ASCII letter and 0 for any
+// other byte.
+func foldLetter(c byte) byte {
+	switch {
+	case c >= 'a' && c <= 'z':
+		return c
+	case c >= 'A' && c <= 'Z':
+		return c + 'a' - 'A'
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"testing"
+)
+
+var allScorers = []scorer{l1Scorer{}, chiSquaredScorer{}, logLikelihoodScorer{}, printableScorer{}}
+
+func TestScorersPreferText(t *testing.T) {
+	text := []byte("It was the best of times, it was the worst of times.\n")
+	noise := make([]byte, len(text))
+	rand.Read(noise)
+	for _, s := range allScorers {
+		if s.score(text) >= s.score(noise) {
+			t.Errorf("%T: score(text) = %v, score(noise) = %v", s, s.score(text), s.score(noise))
+		}
+		if got := s.score(nil); got != 0 {
+			t.Errorf("%T: score(nil) = %v, want 0", s, got)
+		}
+	}
+}
+
+func TestScorersCrackChallenge1_3(t *testing.T) {
+	input := []hex{"1b37373331363f78151b7f2b783431333d78397828372d363c78373e783a393b3736"}
+	for _, s := range []scorer{l1Scorer{}, chiSquaredScorer{}, logLikelihoodScorer{}, englishBytes, langProfiles} {
+		got, err := bestHexLine(input, s)
+		if err != nil || got != "Cooking MC's like a pound of bacon" {
+			t.Errorf("%T: bestHexLine = %q, '%v'", s, got, err)
+		}
+	}
+}
+
+// oldL1Scorer and oldLogLikelihoodScorer score the way l1Scorer and
+// logLikelihoodScorer did before histograms, decrypting with every key and
+// going over the plaintext once per letter. They are the baseline in the
+// benchmarks.
+type oldL1Scorer struct{}
+
+func (oldL1Scorer) score(bytes []byte) float32 {
+	if len(bytes) == 0 {
+		return 0
+	}
+	score := float32(0)
+	for c, f1 := range letterFreq {
+		count := 0
+		for _, x := range bytes {
+			if c == x {
+				count++
+			}
+		}
+		f2 := float32(count) / float32(len(bytes))
+		score += absFloat32(f2 - f1) // L1 norm
+	}
+	return score
+}
+
+type oldLogLikelihoodScorer struct{}
+
+func (oldLogLikelihoodScorer) score(bytes []byte) float32 {
+	if len(bytes) == 0 {
+		return 0
+	}
+	var sum float32
+	for _, c := range bytes {
+		sum += llLogProbs[c]
+	}
+	return -sum / float32(len(bytes))
+}
+
+// benchScorers pairs each histogram scorer with its old version.
+var benchScorers = []struct{ old, new scorer }{
+	{oldL1Scorer{}, l1Scorer{}},
+	{oldLogLikelihoodScorer{}, logLikelihoodScorer{}},
+}
+
+func TestHistogramScorersMatchBytes(t *testing.T) {
+	input, _, err := loadFile("data/6.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	input = input[:200]
+	h := byteHistogram(input)
+	for _, s := range append(allScorers, englishBytes, englishBytesFolded) {
+		hs, ok := s.(histogramScorer)
+		if !ok {
+			t.Errorf("%T isn't a histogramScorer", s)
+			continue
+		}
+		for key := 0; key < 256; key++ {
+			// The sums are taken in different orders, so allow for rounding.
+			plaintext, _ := repeatingKeyXor(input, []byte{byte(key)})
+			want := s.score(plaintext)
+			got := hs.scoreHistogram(h, byte(key))
+			if absFloat32(got-want) > 1e-5*absFloat32(want) {
+				t.Errorf("%T: key %#02x scored %v, want %v", s, key, got, want)
+				break
+			}
+		}
+	}
+}
+
+func TestOldScorersMatch(t *testing.T) {
+	input, _, err := loadFile("data/6.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range benchScorers {
+		got, want := s.old.score(input), s.new.score(input)
+		if absFloat32(got-want) > 1e-5*absFloat32(want) {
+			t.Errorf("%T: old version scored %v, want %v", s.new, got, want)
+		}
+	}
+}
+
+func BenchmarkChallenge1_4(b *testing.B) {
+	hexs, err := readHexLines("data/4.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	for _, s := range benchScorers {
+		b.Run(fmt.Sprintf("%T/old", s.new), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				crackSingleCharXorHexs(hexs, s.old, 1)
+			}
+		})
+		b.Run(fmt.Sprintf("%T/histogram", s.new), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				crackSingleCharXorHexs(hexs, s.new, 1)
+			}
+		})
+	}
+}
+
+func BenchmarkChallenge1_6(b *testing.B) {
+	input, _, err := loadFile("data/6.txt")
+	if err != nil {
+		b.Fatal(err)
+	}
+	opts := xorOptions{workers: 1}
+	for _, s := range benchScorers {
+		b.Run(fmt.Sprintf("%T/old", s.new), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				crackRepeatingKeyXor(context.Background(), input, s.old, opts)
+			}
+		})
+		b.Run(fmt.Sprintf("%T/histogram", s.new), func(b *testing.B) {
+			for i := 0; i < b.N; i++ {
+				crackRepeatingKeyXor(context.Background(), input, s.new, opts)
+			}
+		})
+	}
+}
@@ -0,0 +1,301 @@
+package main
+
+import "io"
+
+const streamBufSize = 4096
+
+// hexEncoder hex encodes everything written to it and passes it on to w.
+type hexEncoder struct {
+	w   io.Writer
+	buf [streamBufSize]byte
+}
+
+func newHexEncoder(w io.Writer) io.Writer {
+	return &hexEncoder{w: w}
+}
+
+func (e *hexEncoder) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		chunk := len(p)
+		if chunk > len(e.buf)/2 {
+			chunk = len(e.buf) / 2
+		}
+		encodeHex(e.buf[:], p[:chunk])
+		if _, err := e.w.Write(e.buf[:2*chunk]); err != nil {
+			return n, err
+		}
+		n += chunk
+		p = p[chunk:]
+	}
+	return n, nil
+}
+
+// hexDecoder decodes hex read from r. Line breaks are skipped so that wrapped
+// input can be streamed as is.
+type hexDecoder struct {
+	r        io.Reader
+	buf      [streamBufSize]byte
+	pos, end int
+	offset   int64 // Offset in r of buf[pos]
+	hi       byte
+	half     bool // Set when hi holds a nibble waiting for its pair
+	err      error
+}
+
+func newHexDecoder(r io.Reader) io.Reader {
+	return &hexDecoder{r: r}
+}
+
+func (d *hexDecoder) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if d.pos == d.end {
+			if d.err != nil || n > 0 {
+				break
+			}
+			d.fill()
+			continue
+		}
+		c := d.buf[d.pos]
+		d.pos++
+		d.offset++
+		if c == '\n' || c == '\r' {
+			continue
+		}
+		x := byteFromHex(c)
+		if x > 15 {
+			d.err = &decodeError{codec: "hex", offset: d.offset - 1, char: c, err: ErrInvalidHexChar}
+			d.pos, d.end = 0, 0
+			break
+		}
+		if !d.half {
+			d.hi, d.half = x, true
+			continue
+		}
+		p[n] = d.hi<<4 | x
+		d.half = false
+		n++
+	}
+	if n > 0 {
+		return n, nil
+	}
+	if d.err == io.EOF && d.half {
+		d.err = &decodeError{codec: "hex", offset: d.offset, length: true, err: ErrInvalidHexLen}
+	}
+	return 0, d.err
+}
+
+func (d *hexDecoder) fill() {
+	var n int
+	n, d.err = d.r.Read(d.buf[:])
+	d.pos, d.end = 0, n
+}
+
+// base64Encoder encodes everything written to it using enc and passes it on
+// to w. Close must be called to flush the final, possibly padded, quad.
+type base64Encoder struct {
+	enc   base64Encoding
+	w     io.Writer
+	buf   [streamBufSize]byte
+	extra [3]byte
+	nx    int // Number of bytes in extra
+}
+
+func newBase64Encoder(enc base64Encoding, w io.Writer) io.WriteCloser {
+	return &base64Encoder{enc: enc, w: w}
+}
+
+func (e *base64Encoder) Write(p []byte) (int, error) {
+	n := 0
+	if e.nx > 0 {
+		for ; e.nx < 3 && n < len(p); n++ {
+			e.extra[e.nx] = p[n]
+			e.nx++
+		}
+		if e.nx < 3 {
+			return n, nil
+		}
+		e.enc.encodeTo(e.buf[:], e.extra[:])
+		if _, err := e.w.Write(e.buf[:4]); err != nil {
+			return n, err
+		}
+		e.nx = 0
+	}
+	for len(p)-n >= 3 {
+		chunk := (len(p) - n) / 3 * 3
+		if chunk > len(e.buf)/4*3 {
+			chunk = len(e.buf) / 4 * 3
+		}
+		e.enc.encodeTo(e.buf[:], p[n:n+chunk])
+		if _, err := e.w.Write(e.buf[:chunk/3*4]); err != nil {
+			return n, err
+		}
+		n += chunk
+	}
+	e.nx = copy(e.extra[:], p[n:])
+	return len(p), nil
+}
+
+func (e *base64Encoder) Close() error {
+	if e.nx == 0 {
+		return nil
+	}
+	e.enc.encodeTo(e.buf[:], e.extra[:e.nx])
+	_, err := e.w.Write(e.buf[:e.enc.encodedLen(e.nx)])
+	e.nx = 0
+	return err
+}
+
+// base64Decoder decodes base64 read from r using enc, following the same rules
+// as enc.decode. Line breaks are skipped so that wrapped input can be streamed
+// as is. Padding ends the stream, anything but line breaks after it is an
+// error.
+type base64Decoder struct {
+	enc      base64Encoding
+	r        io.Reader
+	buf      [streamBufSize]byte
+	pos, end int
+	offset   int64 // Offset in r of buf[pos]
+	quad     [4]byte
+	nq       int   // Number of symbols in quad, including padding
+	npad     int   // Number of padding symbols seen
+	done     bool  // Set after a padded quad
+	sym      byte  // Last symbol other than padding
+	symOff   int64 // Offset of sym
+	padOff   int64 // Offset of the last padding symbol
+	out      [3]byte
+	opos     int // Decoded bytes in out[opos:olen] are not yet returned
+	olen     int
+	err      error
+}
+
+func newBase64Decoder(enc base64Encoding, r io.Reader) io.Reader {
+	return &base64Decoder{enc: enc, r: r}
+}
+
+func (d *base64Decoder) Read(p []byte) (int, error) {
+	n := 0
+	for n < len(p) {
+		if d.opos < d.olen {
+			k := copy(p[n:], d.out[d.opos:d.olen])
+			d.opos += k
+			n += k
+			continue
+		}
+		if d.pos == d.end {
+			if d.err == io.EOF && d.nq > 0 {
+				if err := d.finish(); err != nil {
+					d.err = d.errorAt(err, '=', d.padOff)
+					break
+				}
+				continue
+			}
+			if d.err != nil || n > 0 {
+				break
+			}
+			d.fill()
+			continue
+		}
+		c := d.buf[d.pos]
+		d.pos++
+		d.offset++
+		if c == '\n' || c == '\r' {
+			continue
+		}
+		if err := d.accept(c, d.offset-1); err != nil {
+			d.err = d.errorAt(err, c, d.offset-1)
+			d.pos, d.end = 0, 0
+			break
+		}
+	}
+	if n > 0 {
+		return n, nil
+	}
+	return 0, d.err
+}
+
+// errorAt reports err for char c at offset, or at the spot it really refers
+// to for length and trailing bits errors.
+func (d *base64Decoder) errorAt(err error, c byte, offset int64) error {
+	switch err {
+	case ErrInvalidB64Len:
+		return &decodeError{codec: "base64", offset: d.offset, length: true, err: err}
+	case ErrInvalidB64Bits:
+		c, offset = d.sym, d.symOff
+	}
+	return &decodeError{codec: "base64", offset: offset, char: c, err: err}
+}
+
+// accept adds c, found at offset, to the current quad, decoding it once it is
+// complete.
+func (d *base64Decoder) accept(c byte, offset int64) error {
+	if d.done {
+		return ErrInvalidB64Pad
+	}
+	if c == '=' {
+		if d.nq < 2 || (d.enc.strict && !d.enc.padded) {
+			return ErrInvalidB64Pad
+		}
+		d.quad[d.nq] = 0
+		d.nq++
+		d.npad++
+		d.padOff = offset
+	} else {
+		x := d.enc.value(c)
+		switch {
+		case x > 63:
+			return ErrInvalidB64Char
+		case d.npad > 0:
+			return ErrInvalidB64Pad
+		}
+		d.quad[d.nq] = x
+		d.nq++
+		d.sym, d.symOff = c, offset
+	}
+	if d.nq < 4 {
+		return nil
+	}
+	d.nq -= d.npad
+	d.done = d.npad > 0
+	err := d.decodeQuad()
+	d.nq = 0
+	return err
+}
+
+// finish decodes the final partial quad once r is exhausted.
+func (d *base64Decoder) finish() error {
+	switch {
+	case d.npad > 0:
+		return ErrInvalidB64Pad
+	case d.nq == 1:
+		return ErrInvalidB64Len
+	case d.enc.strict && d.enc.padded:
+		return ErrInvalidB64Len
+	}
+	err := d.decodeQuad()
+	d.nq = 0
+	return err
+}
+
+// decodeQuad decodes the first d.nq symbols of d.quad into d.out.
+func (d *base64Decoder) decodeQuad() error {
+	for i := d.nq; i < 4; i++ {
+		d.quad[i] = 0
+	}
+	packed := (int32(d.quad[0]) << 18) | (int32(d.quad[1]) << 12) | (int32(d.quad[2]) << 6) | int32(d.quad[3])
+	if d.nq < 4 && d.enc.strict && packed&trailingBitsMask(d.nq) != 0 {
+		return ErrInvalidB64Bits
+	}
+	d.out[0] = byte(packed >> 16)
+	d.out[1] = byte(packed >> 8)
+	d.out[2] = byte(packed)
+	d.opos, d.olen = 0, d.nq*3/4
+	return nil
+}
+
+func (d *base64Decoder) fill() {
+	var n int
+	n, d.err = d.r.Read(d.buf[:])
+	d.pos, d.end = 0, n
+}
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+// writeChunked writes p to w in randomly sized chunks.
+func writeChunked(w io.Writer, p []byte) error {
+	for len(p) > 0 {
+		n := 1 + rand.Intn(len(p))
+		if _, err := w.Write(p[:n]); err != nil {
+			return err
+		}
+		p = p[n:]
+	}
+	return nil
+}
+
+func TestStreamMatchesStrings(t *testing.T) {
+	for i := 0; i < 200; i++ {
+		bs := make([]byte, rand.Intn(3*streamBufSize))
+		rand.Read(bs)
+
+		var hexOut, b64Out bytes.Buffer
+		if err := writeChunked(newHexEncoder(&hexOut), bs); err != nil {
+			t.Fatal(err)
+		}
+		enc := newBase64Encoder(stdBase64, &b64Out)
+		if err := writeChunked(enc, bs); err != nil {
+			t.Fatal(err)
+		}
+		if err := enc.Close(); err != nil {
+			t.Fatal(err)
+		}
+		if want := toHexString(bs); hex(hexOut.String()) != want {
+			t.Fatalf("hexEncoder = '%v', want '%v'", hexOut.String(), want)
+		}
+		if want := toBase64String(bs); base64(b64Out.String()) != want {
+			t.Fatalf("base64Encoder = '%v', want '%v'", b64Out.String(), want)
+		}
+
+		got, err := ioutil.ReadAll(newHexDecoder(iotest.OneByteReader(&hexOut)))
+		if err != nil || !bytes.Equal(got, bs) {
+			t.Fatalf("hexDecoder = '%v' (%v), want '%v'", got, err, bs)
+		}
+		got, err = ioutil.ReadAll(newBase64Decoder(stdBase64, iotest.HalfReader(&b64Out)))
+		if err != nil || !bytes.Equal(got, bs) {
+			t.Fatalf("base64Decoder = '%v' (%v), want '%v'", got, err, bs)
+		}
+	}
+}
+
+func TestStreamDecodeErrors(t *testing.T) {
+	tests := []struct {
+		input   string
+		base64  bool
+		wanterr error
+		offset  int64
+		char    byte
+	}{
+		{"4d61\n6e6", false, ErrInvalidHexLen, 8, 0},
+		{"4d6x6e", false, ErrInvalidHexChar, 3, 'x'},
+		{"TWFu\nTW=u", true, ErrInvalidB64Pad, 8, 'u'},
+		{"TWFu\nEA==\nTWFu", true, ErrInvalidB64Pad, 10, 'T'},
+		{"TWFuTWF", true, ErrInvalidB64Len, 7, 0},
+		{"TW-u", true, ErrInvalidB64Char, 2, '-'},
+		{"TWFuTR==\n", true, ErrInvalidB64Bits, 5, 'R'},
+	}
+	for _, test := range tests {
+		var r io.Reader = newHexDecoder(strings.NewReader(test.input))
+		if test.base64 {
+			r = newBase64Decoder(stdBase64, strings.NewReader(test.input))
+		}
+		_, err := ioutil.ReadAll(r)
+		var derr *decodeError
+		if !errors.Is(err, test.wanterr) || !errors.As(err, &derr) ||
+			derr.offset != test.offset || derr.char != test.char {
+			t.Errorf("decoding %q = '%v', want '%v' at offset %d", test.input, err, test.wanterr, test.offset)
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"errors"
+	"io"
+)
+
+var (
+	ErrInvalidWhence  = errors.New("repeatingKeyXorStream.Seek: invalid whence")
+	ErrNegativeOffset = errors.New("repeatingKeyXorStream.Seek: negative offset")
+)
+
+// repeatingKeyXorStream is a cipher.Stream whose keystream is key repeated
+// forever. offset is how far into the keystream it is, so that it can be moved
+// to where a chunk of a longer message starts.
+type repeatingKeyXorStream struct {
+	key    []byte
+	offset int64
+}
+
+func newRepeatingKeyXorStream(key []byte) (*repeatingKeyXorStream, error) {
+	if len(key) == 0 {
+		return nil, ErrEmptyKey
+	}
+	return &repeatingKeyXorStream{key: append([]byte(nil), key...)}, nil
+}
+
+// XORKeyStream XORs src with the keystream from the current offset into dst,
+// which may be src, and moves the offset past it.
+func (s *repeatingKeyXorStream) XORKeyStream(dst, src []byte) {
+	if len(dst) < len(src) {
+		panic("repeatingKeyXorStream: output smaller than input")
+	}
+	k := int(s.offset % int64(len(s.key)))
+	for i, c := range src {
+		dst[i] = c ^ s.key[k]
+		if k++; k == len(s.key) {
+			k = 0
+		}
+	}
+	s.offset += int64(len(src))
+}
+
+// Seek moves to offset in the keystream as io.Seeker does. The keystream has
+// no end, so io.SeekEnd is invalid.
+func (s *repeatingKeyXorStream) Seek(offset int64, whence int) (int64, error) {
+	switch whence {
+	case io.SeekStart:
+	case io.SeekCurrent:
+		offset += s.offset
+	default:
+		return s.offset, ErrInvalidWhence
+	}
+	if offset < 0 {
+		return s.offset, ErrNegativeOffset
+	}
+	s.offset = offset
+	return offset, nil
+}
+
+// xorReader XORs everything read from r with the keystream of s.
+type xorReader struct {
+	r io.Reader
+	s *repeatingKeyXorStream
+}
+
+func newXorReader(r io.Reader, s *repeatingKeyXorStream) io.Reader {
+	return &xorReader{r, s}
+}
+
+func (x *xorReader) Read(p []byte) (int, error) {
+	n, err := x.r.Read(p)
+	x.s.XORKeyStream(p[:n], p[:n])
+	return n, err
+}
+
+// xorWriter XORs everything written to it with the keystream of s and passes
+// it on to w. p itself is left as it was.
+type xorWriter struct {
+	w   io.Writer
+	s   *repeatingKeyXorStream
+	buf [streamBufSize]byte
+}
+
+func newXorWriter(w io.Writer, s *repeatingKeyXorStream) io.Writer {
+	return &xorWriter{w: w, s: s}
+}
+
+func (x *xorWriter) Write(p []byte) (int, error) {
+	n := 0
+	for len(p) > 0 {
+		chunk := min(len(p), len(x.buf))
+		x.s.XORKeyStream(x.buf[:], p[:chunk])
+		m, err := x.w.Write(x.buf[:chunk])
+		n += m
+		if err != nil {
+			// Leave the keystream where w stopped.
+			x.s.Seek(int64(m-chunk), io.SeekCurrent)
+			return n, err
+		}
+		p = p[chunk:]
+	}
+	return n, nil
+}
@@ -0,0 +1,108 @@
+package main
+
+import (
+	"bytes"
+	"crypto/cipher"
+	"errors"
+	"io"
+	"io/ioutil"
+	"math/rand"
+	"testing"
+	"testing/iotest"
+)
+
+var _ cipher.Stream = (*repeatingKeyXorStream)(nil)
+
+func TestRepeatingKeyXorStream(t *testing.T) {
+	key := []byte("ICE")
+	for i := 0; i < 50; i++ {
+		bs := make([]byte, rand.Intn(3*streamBufSize))
+		rand.Read(bs)
+		want, _ := repeatingKeyXor(bs, key)
+
+		s, err := newRepeatingKeyXorStream(key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var out bytes.Buffer
+		if err := writeChunked(newXorWriter(&out, s), bs); err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(out.Bytes(), want) {
+			t.Fatal("xorWriter doesn't match repeatingKeyXor")
+		}
+
+		s, _ = newRepeatingKeyXorStream(key)
+		got, err := ioutil.ReadAll(newXorReader(iotest.HalfReader(bytes.NewReader(want)), s))
+		if err != nil || !bytes.Equal(got, bs) {
+			t.Fatalf("xorReader = '%v' (%v), want '%v'", got, err, bs)
+		}
+	}
+}
+
+func TestRepeatingKeyXorStreamSeek(t *testing.T) {
+	key := []byte("secret")
+	plaintext := []byte("Pay Alice $100 on Monday")
+	ciphertext, _ := repeatingKeyXor(plaintext, key)
+	s, _ := newRepeatingKeyXorStream(key)
+
+	// Patch the amount in place without touching the rest.
+	if off, err := s.Seek(11, io.SeekStart); err != nil || off != 11 {
+		t.Fatalf("got %d, '%v'", off, err)
+	}
+	s.XORKeyStream(ciphertext[11:14], []byte("999"))
+	want, _ := repeatingKeyXor([]byte("Pay Alice $999 on Monday"), key)
+	if !bytes.Equal(ciphertext, want) {
+		t.Errorf("got %q, want %q", ciphertext, want)
+	}
+
+	if off, err := s.Seek(-14, io.SeekCurrent); err != nil || off != 0 {
+		t.Errorf("got %d, '%v'", off, err)
+	}
+	if _, err := s.Seek(-1, io.SeekCurrent); err != ErrNegativeOffset {
+		t.Errorf("got '%v', want '%v'", err, ErrNegativeOffset)
+	}
+	if _, err := s.Seek(0, io.SeekEnd); err != ErrInvalidWhence {
+		t.Errorf("got '%v', want '%v'", err, ErrInvalidWhence)
+	}
+	if _, err := newRepeatingKeyXorStream(nil); err != ErrEmptyKey {
+		t.Errorf("got '%v', want '%v'", err, ErrEmptyKey)
+	}
+}
+
+// shortWriter accepts n bytes and fails after that.
+type shortWriter struct {
+	bytes.Buffer
+	n int
+}
+
+var errShortWrite = errors.New("short write")
+
+func (w *shortWriter) Write(p []byte) (int, error) {
+	if len(p) > w.n {
+		n, _ := w.Buffer.Write(p[:w.n])
+		w.n = 0
+		return n, errShortWrite
+	}
+	w.n -= len(p)
+	return w.Buffer.Write(p)
+}
+
+func TestXorWriterShortWrite(t *testing.T) {
+	key := []byte("key")
+	plaintext := []byte("some plaintext")
+	want, _ := repeatingKeyXor(plaintext, key)
+	s, _ := newRepeatingKeyXorStream(key)
+	w := &shortWriter{n: 5}
+	if n, err := newXorWriter(w, s).Write(plaintext); n != 5 || err != errShortWrite {
+		t.Fatalf("got %d, '%v'", n, err)
+	}
+	// The keystream continues where the write stopped, so retrying works.
+	w.n = len(plaintext)
+	if _, err := newXorWriter(w, s).Write(plaintext[5:]); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(w.Bytes(), want) {
+		t.Errorf("got %x, want %x", w.Bytes(), want)
+	}
+}